@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/gosimple/slug"
+	"github.com/moulco/moul/internal"
+	"github.com/spf13/cobra"
+)
+
+// Cache cmd
+var Cache = &cobra.Command{
+	Use:   "cache",
+	Short: "Manage the photo cache",
+}
+
+// CacheVerify cmd
+var CacheVerify = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify cached photos",
+	Long:  `Verify every photo is cached with all its variants and placeholder decoding. Export processes the others again.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		dir, err := internal.GetDirectory()
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		if err := internal.CheckManifest(); err != nil {
+			color.Red(err.Error())
+			os.Exit(1)
+		}
+
+		config := internal.GetConfig()
+		targets := getTargets(dir, config)
+		healthy, issues := internal.VerifyCache(slug.Make(config.GetString("profile.name")), targets)
+		for _, issue := range issues {
+			color.Yellow(strings.TrimPrefix(issue.Error(), dir+string(filepath.Separator)))
+		}
+
+		stale, err := internal.Collect(targets, true)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+
+		fmt.Printf("\n● %d photo(s) cached", healthy)
+		if len(issues) == 0 {
+			color.Green(" ✔")
+		} else {
+			fmt.Printf(", %d issue(s), export processes photos with missing or broken files again\n", len(issues))
+		}
+		if len(stale) > 0 {
+			fmt.Printf("● %d stale file(s), remove them with `moul clean`\n", len(stale))
+		}
+	},
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/fatih/color"
+	"github.com/moulco/moul/internal"
+	"github.com/spf13/cobra"
+)
+
+// Check cmd
+var Check = &cobra.Command{
+	Use:   "check",
+	Short: "Check photos before export",
+	Long:  `Check photos for issues that change how they look once exported.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		dir, err := internal.GetDirectory()
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		profile := internal.GetConfig().GetString("image.profile")
+		warnings := 0
+		for _, photo := range internal.GetPhotos(filepath.Join(dir, "photos")) {
+			rel, _ := filepath.Rel(dir, photo)
+			if err := internal.CheckDecode(photo); err != nil {
+				color.Red("%s: %v, it is skipped on export", rel, err)
+				warnings++
+				continue
+			}
+			if issue := internal.ProfileIssue(photo, profile); issue != "" {
+				color.Yellow("%s: %s", rel, issue)
+				warnings++
+			}
+		}
+
+		if warnings == 0 {
+			fmt.Print("● No issues found")
+			color.Green(" ✔")
+			return
+		}
+		fmt.Printf("\n● %d warning(s)\n", warnings)
+	},
+}
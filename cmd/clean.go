@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/fatih/color"
+	"github.com/moulco/moul/internal"
+	"github.com/spf13/cobra"
+)
+
+// Clean cmd
+var Clean = &cobra.Command{
+	Use:   "clean",
+	Short: "Remove stale photos from the cache",
+	Long:  `Remove cached photos that were deleted, replaced or exported with other settings. Export does the same after processing photos.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		dir, err := internal.GetDirectory()
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		// a cache of any version can be removed
+		if err := internal.CheckManifest(); err != nil && !all {
+			color.Red(err.Error())
+			os.Exit(1)
+		}
+		targets := getTargets(dir, internal.GetConfig())
+
+		var stale []string
+		if all {
+			filepath.Walk(".moul", func(path string, info os.FileInfo, err error) error {
+				if err == nil && !info.IsDir() {
+					stale = append(stale, path)
+				}
+				return nil
+			})
+		} else if stale, err = internal.Collect(targets, true); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		var size int64
+		for _, path := range stale {
+			if info, err := os.Stat(path); err == nil {
+				size += info.Size()
+			}
+			if dryRun {
+				fmt.Println("  " + path)
+			}
+		}
+		if dryRun {
+			fmt.Printf("● %d file(s) would be removed, %.1f MB", len(stale), float64(size)/1e6)
+			color.Yellow(" (dry run)")
+			return
+		}
+
+		if all {
+			// every photo is processed again on next export
+			err = os.RemoveAll(".moul")
+		} else {
+			_, err = internal.Collect(targets, false)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Printf("● Removed %d file(s), %.1f MB", len(stale), float64(size)/1e6)
+		color.Green(" ✔")
+	},
+}
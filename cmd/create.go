@@ -23,7 +23,6 @@ var Create = &cobra.Command{
 		s := spinner.New(spinner.CharSets[21], 100*time.Millisecond)
 		s.Prefix = "■ Creating a new collection... "
 		s.Start()
-		time.Sleep(1 * time.Second)
 		if _, err := os.Stat(args[0]); !os.IsNotExist(err) {
 			color.Yellow("`%s` already exists!", args[0])
 			os.Exit(1)
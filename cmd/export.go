@@ -6,12 +6,12 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/blang/semver"
-	"github.com/briandowns/spinner"
 	"github.com/fatih/color"
 	"github.com/gobuffalo/helpers/iterators"
 	"github.com/gobuffalo/helpers/text"
@@ -29,6 +29,31 @@ import (
 	"github.com/tdewolff/minify/v2/svg"
 )
 
+// getTargets returns photo directories to export, `cover`, `avatar` and
+// sections are optional
+func getTargets(dir string, moulConfig *viper.Viper) []internal.Target {
+	var targets []internal.Target
+	for _, photoType := range []string{"cover", "avatar", "collection"} {
+		path := filepath.Join(dir, "photos", photoType)
+		if _, err := os.Stat(path); err == nil {
+			targets = append(targets, internal.Target{Path: path, Prefix: photoType, Sizes: internal.GetSizes(photoType)})
+		}
+	}
+	var sections []string
+	for k := range moulConfig.GetStringMap("section") {
+		sections = append(sections, k)
+	}
+	sort.Strings(sections)
+	for _, k := range sections {
+		sectionPath := filepath.Join(dir, "photos", "section", k)
+		if _, err := os.Stat(sectionPath); err == nil {
+			prefix := slug.Make(filepath.Join("section", k))
+			targets = append(targets, internal.Target{Path: sectionPath, Prefix: prefix, Sizes: internal.GetSizes(prefix)})
+		}
+	}
+	return targets
+}
+
 // Export cmd
 var Export = &cobra.Command{
 	Use:   "export",
@@ -43,9 +68,6 @@ var Export = &cobra.Command{
 			color.Green(" moul update\n\n")
 		}
 
-		s := spinner.New(spinner.CharSets[21], 100*time.Millisecond)
-		s.Prefix = "■ Exporting photo collection... "
-		s.Start()
 		start := time.Now()
 
 		dir, err := internal.GetDirectory()
@@ -53,6 +75,10 @@ var Export = &cobra.Command{
 			fmt.Println(err)
 			os.Exit(1)
 		}
+		if err := internal.CheckManifest(); err != nil {
+			color.Red(err.Error())
+			os.Exit(1)
+		}
 
 		collectionPath := filepath.Join(dir, "photos", "collection")
 		if _, err := os.Stat(collectionPath); os.IsNotExist(err) {
@@ -76,24 +102,32 @@ var Export = &cobra.Command{
 		coverPath := filepath.Join(dir, "photos", "cover")
 		if _, err := os.Stat(coverPath); os.IsNotExist(err) {
 			color.Yellow("Skipped `cover`")
-		} else {
-			internal.Resize(coverPath, slugName, "cover", []int{2560, 1280, 620})
 		}
 
 		avatarPath := filepath.Join(dir, "photos", "avatar")
 		if _, err := os.Stat(avatarPath); os.IsNotExist(err) {
 			color.Yellow("Skipped `avatar`")
-		} else {
-			internal.Resize(avatarPath, slugName, "avatar", []int{512, 320})
 		}
 
-		config := viper.New()
-		config.AddConfigPath(".moul")
-		config.SetConfigType("toml")
+		targets := getTargets(dir, moulConfig)
+
+		// every photo is processed up front, rendering only reads the cache
+		total := 0
+		for _, t := range targets {
+			total += len(internal.GetPhotos(t.Path))
+		}
+		progress := internal.NewProgress(os.Stdout, total)
+		failed := internal.NewPipeline(jobs, progress).ResizeAll(slugName, targets)
+		progress.Stop()
+		if len(failed) > 0 {
+			color.Yellow("Skipped %d photo(s) that could not be processed:", len(failed))
+			for _, err := range failed {
+				fmt.Println("  " + err.Error())
+			}
+		}
 
+		config := internal.GetManifest("cover")
 		coverPhotos := internal.GetPhotos(coverPath)
-		config.SetConfigName("cover")
-		config.ReadInConfig()
 
 		var cname, aname string
 
@@ -101,32 +135,150 @@ var Export = &cobra.Command{
 			cname = filepath.Base(coverPhotos[0])
 		}
 		cid := config.GetString(slug.Make(cname) + ".id")
-		coverPathToSqip := filepath.Join(".moul", "photos", cid, "cover", "sqip",
-			internal.GetFileName(cname, slugName)+".svg",
-		)
-		inlineCover := internal.GetEncodedSvg(coverPathToSqip)
-		cover := map[string]string{
-			"id":   cid,
-			"name": internal.GetFileName(cname, slugName),
-			"sqip": inlineCover,
+		inlineCover := internal.GetEncodedPlaceholder(internal.GetPlaceholderPath(cid, "cover",
+			internal.GetFileName(cname, slugName),
+			config.GetString(slug.Make(cname)+".placeholder"),
+		))
+		coverSizes := config.GetIntSlice(slug.Make(cname) + ".sizes")
+		if want := internal.GetSizes("cover"); len(coverPhotos) > 0 && len(want) > 0 &&
+			internal.IsUndersized(coverPhotos[0], want[0], internal.GetFit("cover")) {
+			w, h := internal.GetPhotoDimension(coverPhotos[0])
+			color.Yellow("The cover is %dx%d, smaller than the %dpx requested, it may look blurry on large screens", w, h, want[0])
+		}
+		// crops of small covers are narrower than the cover
+		cropSizes := map[string][]int{}
+		for _, c := range config.GetStringSlice(slug.Make(cname) + ".crops") {
+			cropSizes[c] = config.GetIntSlice(slug.Make(cname) + ".cropsizes." + c)
+			if len(cropSizes[c]) == 0 {
+				cropSizes[c] = coverSizes
+			}
+		}
+		cover := map[string]interface{}{
+			"id":          cid,
+			"name":        internal.GetFileName(cname, slugName),
+			"placeholder": inlineCover,
+			"formats":     config.GetStringSlice(slug.Make(cname) + ".formats"),
+			"sizes":       coverSizes,
+			"color":       config.GetString(slug.Make(cname) + ".color"),
+			"position":    internal.GetCachedObjectPosition(config, slug.Make(cname)),
+			"crops":       config.GetStringSlice(slug.Make(cname) + ".crops"),
+			"cropSizes":   cropSizes,
+			"fallback":    internal.GetFallback(config, slug.Make(cname)),
+			"og": internal.GetPhotoURL(cid, "cover", internal.GetFileName(cname, slugName), internal.GetFallback(config, slug.Make(cname)),
+				internal.GetClosestSize(coverSizes, 1200),
+			),
 		}
 
 		avatarPhotos := internal.GetPhotos(avatarPath)
-		config.SetConfigName("avatar")
-		config.ReadInConfig()
+		config = internal.GetManifest("avatar")
 
 		if len(avatarPhotos) > 0 {
 			aname = filepath.Base(avatarPhotos[0])
 		}
 		aid := config.GetString(slug.Make(aname) + ".id")
-		avatarPathToSqip := filepath.Join(".moul", "photos", aid, "avatar", "sqip",
-			internal.GetFileName(aname, slugName)+".svg",
-		)
-		inlineAvatar := internal.GetEncodedSvg(avatarPathToSqip)
-		avatar := map[string]string{
-			"id":   aid,
-			"name": internal.GetFileName(aname, slugName),
-			"sqip": inlineAvatar,
+		inlineAvatar := internal.GetEncodedPlaceholder(internal.GetPlaceholderPath(aid, "avatar",
+			internal.GetFileName(aname, slugName),
+			config.GetString(slug.Make(aname)+".placeholder"),
+		))
+		avatarSizes := config.GetIntSlice(slug.Make(aname) + ".sizes")
+		avatar := map[string]interface{}{
+			"id":          aid,
+			"name":        internal.GetFileName(aname, slugName),
+			"placeholder": inlineAvatar,
+			"formats":     config.GetStringSlice(slug.Make(aname) + ".formats"),
+			"sizes":       avatarSizes,
+			"color":       config.GetString(slug.Make(aname) + ".color"),
+			"fallback":    internal.GetFallback(config, slug.Make(aname)),
+		}
+		if len(avatarSizes) > 0 {
+			avatar["src"] = internal.GetPhotoURL(aid, "avatar", internal.GetFileName(aname, slugName), avatar["fallback"].(string),
+				avatarSizes[len(avatarSizes)-1],
+			)
+			avatar["srcHd"] = internal.GetPhotoURL(aid, "avatar", internal.GetFileName(aname, slugName), avatar["fallback"].(string),
+				avatarSizes[0],
+			)
+		}
+
+		// cover focal point aware cards, a custom `card` image replaces a composed one
+		var cards []string
+		var sectionCards []map[string]string
+		card := internal.Card{
+			Name:  moulConfig.GetString("profile.name"),
+			Title: moulConfig.GetString("content.title"),
+		}
+		if len(coverPhotos) > 0 {
+			card.Photo = coverPhotos[0]
+		}
+		if len(avatarPhotos) > 0 {
+			card.Avatar = avatarPhotos[0]
+		}
+		if custom := moulConfig.GetString("content.card"); custom != "" {
+			card = internal.Card{Photo: custom, Custom: true}
+		}
+		if url, err := internal.MakeCard("index", card); err != nil {
+			fmt.Fprintf(os.Stderr, "card: %v\n", err)
+		} else {
+			cover["og"] = url
+			cover["card"] = true
+			cards = append(cards, url)
+		}
+
+		var sectionKeys []string
+		for k := range moulConfig.GetStringMap("section") {
+			sectionKeys = append(sectionKeys, k)
+		}
+		sort.Strings(sectionKeys)
+		for _, k := range sectionKeys {
+			title := moulConfig.GetString("section." + k + ".title")
+			// sections without title nor description are not on the page
+			if title == "" && moulConfig.GetString("section."+k+".description") == "" {
+				continue
+			}
+			sectionCard := internal.Card{
+				Avatar: card.Avatar,
+				Name:   moulConfig.GetString("profile.name"),
+				Title:  title,
+			}
+			// the first photo that decodes, sections without photos get none
+			candidates := []internal.Card{}
+			sectionPath := filepath.Join(dir, "photos", "section", k)
+			if _, err := os.Stat(sectionPath); err == nil {
+				for _, photo := range internal.GetPhotos(sectionPath) {
+					c := sectionCard
+					c.Photo = photo
+					candidates = append(candidates, c)
+				}
+			}
+			if custom := moulConfig.GetString("section." + k + ".card"); custom != "" {
+				candidates = []internal.Card{{Photo: custom, Custom: true}}
+			}
+			var err error
+			for _, c := range candidates {
+				var url string
+				if url, err = internal.MakeCard(slug.Make("section-"+k), c); err == nil {
+					if title == "" {
+						title = moulConfig.GetString("content.title")
+					}
+					sectionCards = append(sectionCards, map[string]string{
+						"anchor": "section-" + k,
+						"title":  title,
+						"card":   url,
+					})
+					cards = append(cards, url)
+					break
+				}
+			}
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "card: section.%s: %v\n", k, err)
+			}
+		}
+
+		// only variants reachable from current sources are kept and published
+		if err := internal.SetCards(cards); err != nil {
+			fmt.Fprintf(os.Stderr, "photos: %v\n", err)
+		}
+		if _, err := internal.Collect(targets, false); err != nil {
+			fmt.Fprintf(os.Stderr, "photos: %v\n", err)
 		}
 
 		t := internal.Template()
@@ -140,6 +292,9 @@ var Export = &cobra.Command{
 			return filepath.Join(path, i)
 		})
 		ctx.Set("getPhotos", internal.GetPhotoProd)
+		ctx.Set("imageType", internal.ImageType)
+		ctx.Set("srcset", internal.GetSrcset)
+		ctx.Set("cropMedia", internal.GetCropMedia)
 
 		ctx.Set("isProd", true)
 		ctx.Set("version", Version)
@@ -170,6 +325,25 @@ var Export = &cobra.Command{
 		}
 		ioutil.WriteFile(filepath.Join(".", ".moul", "index.html"), []byte(mts), 0644)
 
+		config = internal.GetManifest("photos")
+		var published []string
+		for _, k := range config.AllKeys() {
+			published = append(published, config.GetStringSlice(k)...)
+		}
+
+		// checked before copying, a leaking export never reaches the output
+		keepGPS := false
+		for _, field := range internal.GetConfig().GetStringSlice("privacy.keep") {
+			keepGPS = keepGPS || field == "gps"
+		}
+		if leaks := internal.VerifyPrivacy(published); len(leaks) > 0 && !keepGPS {
+			color.Red("\nGPS tags found in published files:")
+			for _, leak := range leaks {
+				fmt.Println("  " + leak)
+			}
+			os.Exit(1)
+		}
+
 		out := filepath.Join(".", output)
 		if _, err := os.Stat(out); !os.IsNotExist(err) {
 			internal.RemoveAll(out)
@@ -191,17 +365,33 @@ var Export = &cobra.Command{
 		}
 		copy.Copy(filepath.Join(".", ".moul", "index.html"), filepath.Join(out, "index.html"))
 
-		config.SetConfigName("photos")
-		config.ReadInConfig()
-
-		for _, k := range config.AllKeys() {
-			for _, v := range config.GetStringSlice(k) {
-				copy.Copy(v, filepath.Join(out, strings.Split(v, ".moul")[1]))
+		// each section card is shared from a page of its own, leading to the
+		// section
+		for _, s := range sectionCards {
+			sctx := plush.NewContext()
+			sctx.Set("base", moulConfig.Get("base"))
+			sctx.Set("profile", moulConfig.Get("profile"))
+			sctx.Set("social", moulConfig.Get("social"))
+			sctx.Set("anchor", s["anchor"])
+			sctx.Set("title", s["title"])
+			sctx.Set("card", s["card"])
+			page, err := plush.Render(internal.SectionTemplate(), sctx)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %v\n", s["anchor"], err)
+				continue
 			}
+			if minified, err := m.String("text/html", page); err == nil {
+				page = minified
+			}
+			os.MkdirAll(filepath.Join(out, s["anchor"]), os.ModePerm)
+			ioutil.WriteFile(filepath.Join(out, s["anchor"], "index.html"), []byte(page), 0644)
+		}
+
+		for _, v := range published {
+			copy.Copy(v, filepath.Join(out, strings.Split(v, ".moul")[1]))
 		}
 
 		fmt.Print("\n● Success! Exported photo collection in")
 		color.Green(" `%s`", time.Since(start))
-		s.Stop()
 	},
 }
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strconv"
 	"time"
 
@@ -36,6 +37,9 @@ const (
 var (
 	output  string
 	verbose bool
+	jobs    int
+	all     bool
+	dryRun  bool
 )
 
 func info(s string) {
@@ -97,13 +101,14 @@ func ImageHandler(w http.ResponseWriter, r *http.Request) {
 
 func getTemplate(moulConfig *viper.Viper, dir string) string {
 	slugName := slug.Make(moulConfig.GetString("profile.name"))
-	var coverName, avatarName string
+	var coverName, coverPosition, avatarName string
 	t := internal.Template()
 	ctx := plush.NewContext()
 
 	cover := internal.GetPhotos(filepath.Join(dir, "photos", "cover"))
 	if len(cover) > 0 {
 		coverName = filepath.Base(cover[0])
+		coverPosition = internal.GetObjectPosition(cover[0])
 	}
 
 	avatar := internal.GetPhotos(filepath.Join(dir, "photos", "avatar"))
@@ -130,8 +135,10 @@ func getTemplate(moulConfig *viper.Viper, dir string) string {
 	ctx.Set("avatar", avatarName)
 
 	ctx.Set("cover", map[string]string{
-		"name": coverName,
+		"name":     coverName,
+		"position": coverPosition,
 	})
+	ctx.Set("sectionCards", []string{})
 	ctx.Set("content", moulConfig.Get("content"))
 	ctx.Set("section", moulConfig.Get("section"))
 	ctx.Set("slugName", slugName)
@@ -160,13 +167,17 @@ func previewFunc(cmd *cobra.Command, args []string) {
 	s := spinner.New(spinner.CharSets[21], 100*time.Millisecond)
 	s.Prefix = "■ Starting dev server... "
 	s.Start()
-	time.Sleep(1 * time.Second)
 	info("Get working directory...")
 	dir, err := internal.GetDirectory()
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
 	}
+	if err := internal.CheckManifest(); err != nil {
+		s.Stop()
+		color.Red(err.Error())
+		os.Exit(1)
+	}
 
 	info("Create moulConfig viper instance...")
 	moulConfig := viper.New()
@@ -194,7 +205,8 @@ func previewFunc(cmd *cobra.Command, args []string) {
 
 	info("Create packr instance...")
 	box := packr.New("assets", "./assets")
-	photoFolder := http.FileServer(http.Dir("photos"))
+	// TIFF and BMP are transcoded, not every browser displays them
+	photoFolder := internal.PreviewHandler("photos")
 	http.Handle("/assets/", http.StripPrefix("/assets/", http.FileServer(box)))
 	http.Handle("/photos/", http.StripPrefix("/photos/", photoFolder))
 
@@ -238,8 +250,15 @@ func Execute() {
 
 	Export.Flags().StringVar(&output, "o", "dist", "output directory")
 	Export.Flags().BoolVar(&verbose, "v", false, "verbose output")
+	Export.Flags().IntVar(&jobs, "jobs", runtime.NumCPU(), "number of photos processed in parallel")
+	Clean.Flags().BoolVar(&all, "all", false, "remove the whole cache")
+	Clean.Flags().BoolVar(&dryRun, "dry-run", false, "list files without removing them")
 
 	rootCmd.AddCommand(Create)
+	rootCmd.AddCommand(Check)
+	rootCmd.AddCommand(Clean)
+	Cache.AddCommand(CacheVerify)
+	rootCmd.AddCommand(Cache)
 	rootCmd.AddCommand(Export)
 	rootCmd.AddCommand(Update)
 	rootCmd.AddCommand(VersionCmd)
@@ -0,0 +1,155 @@
+package internal
+
+import (
+	"image"
+	"sync"
+
+	"github.com/disintegration/imaging"
+	"github.com/fatih/color"
+	"github.com/spf13/viper"
+)
+
+// AlphaOptions of `[image.alpha]`, a sidecar `<photo>.toml` overrides them
+type AlphaOptions struct {
+	Mode       string // auto, flatten or lossless
+	Background string // color transparent areas are flattened onto
+	Adaptive   bool   // the page background follows the visitor's color scheme
+}
+
+// page background of each `style.theme`, light unless the theme is dark
+var themeBackgrounds = map[string]string{
+	"light": "#ffffff",
+	"dark":  "#131619",
+}
+
+var alphaWarned sync.Map
+
+// getAlpha reads `[image.alpha]`, flattening onto the theme background by
+// default
+func getAlpha() AlphaOptions {
+	config := GetConfig()
+	a := AlphaOptions{
+		Mode:       checkAlphaMode("image.alpha.mode", config.GetString("image.alpha.mode")),
+		Background: config.GetString("image.alpha.background"),
+	}
+	if a.Background == "" {
+		a.Background = themeBackgrounds["light"]
+		bg, ok := themeBackgrounds[config.GetString("style.theme")]
+		if ok {
+			a.Background = bg
+		}
+		// `system-preference` pages are light or dark
+		a.Adaptive = !ok
+	}
+	return a
+}
+
+func checkAlphaMode(key, mode string) string {
+	switch mode {
+	case "auto", "flatten", "lossless":
+		return mode
+	}
+	if _, warned := alphaWarned.LoadOrStore(key, true); !warned {
+		color.Yellow("Unknown `%s` value `%s`, using `auto`", key, mode)
+	}
+	return "auto"
+}
+
+// photoAlpha applies `alpha` and `background` of the sidecar of a photo
+func photoAlpha(path string, a AlphaOptions) AlphaOptions {
+	config := readSidecar(path)
+	if config == nil {
+		return a
+	}
+	if config.IsSet("alpha") {
+		a.Mode = checkAlphaMode(path+".toml", config.GetString("alpha"))
+	}
+	if config.IsSet("background") {
+		a.Background = config.GetString("background")
+		a.Adaptive = false
+	}
+	return a
+}
+
+// detectLossless reports whether a photo is published lossless. In `auto`
+// mode sources other than JPEG are decoded to tell graphics from photos,
+// the decoded image is returned to be reused. Transparent photos are kept
+// lossless too when no single background matches the page.
+func detectLossless(path string, opts Options) (bool, image.Image, error) {
+	switch opts.Alpha.Mode {
+	case "lossless":
+		return true, nil, nil
+	case "flatten":
+		return false, nil, nil
+	}
+	if sniffFormat(path) == "jpeg" {
+		return false, nil, nil
+	}
+	img, err := openImage(path, opts.Profile)
+	if err != nil {
+		return false, nil, err
+	}
+	if a := opts.Alpha; a.Adaptive && !imaging.Clone(img).Opaque() {
+		return true, img, nil
+	}
+	return isGraphic(img), img, nil
+}
+
+// isGraphic reports whether an image looks like a logo, a screenshot or an
+// illustration, made of few colors unlike photos
+func isGraphic(img image.Image) bool {
+	// nearest neighbor keeps colors, any filter would blend new ones
+	sample := imaging.Clone(img)
+	if img.Bounds().Dx() > 256 {
+		sample = imaging.Resize(img, 256, 0, imaging.NearestNeighbor)
+	}
+	colors := map[[4]uint8]bool{}
+	for i := 0; i < len(sample.Pix); i += 4 {
+		if sample.Pix[i+3] == 0 {
+			continue
+		}
+		colors[[4]uint8{sample.Pix[i], sample.Pix[i+1], sample.Pix[i+2], sample.Pix[i+3]}] = true
+		if len(colors) > 1024 {
+			return false
+		}
+	}
+	return true
+}
+
+// GetFallback returns the format of an exported photo every browser
+// supports, JPEG or PNG for lossless photos
+func GetFallback(config *viper.Viper, fn string) string {
+	if fallback := config.GetString(fn + ".fallback"); fallback != "" {
+		return fallback
+	}
+	return "jpg"
+}
+
+// outputFormats returns the fallback format of a photo and the others.
+// Lossless photos are published as PNG and lossless WebP, AVIF is left out.
+func outputFormats(lossless bool, formats []string) (string, []string) {
+	if !lossless {
+		return "jpg", formats
+	}
+	var kept []string
+	for _, format := range formats {
+		if format == "webp" {
+			kept = append(kept, format)
+		}
+	}
+	return "png", kept
+}
+
+// flatten composes an image with transparent areas onto a background color
+func flatten(img image.Image, background string) image.Image {
+	src := imaging.Clone(img)
+	if src.Opaque() {
+		return img
+	}
+	r, g, b := parseHexColor(background)
+	bg := image.NewNRGBA(src.Rect)
+	for i := 0; i < len(bg.Pix); i += 4 {
+		bg.Pix[i], bg.Pix[i+1], bg.Pix[i+2], bg.Pix[i+3] = uint8(r*255+0.5), uint8(g*255+0.5), uint8(b*255+0.5), 255
+	}
+	return imaging.Overlay(bg, src, image.Point{}, 1)
+}
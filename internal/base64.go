@@ -6,14 +6,31 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 )
 
-// GetEncodedSvg func
-func GetEncodedSvg(pathToSvg string) string {
-	sqip, _ := os.Open(pathToSvg)
-	reader := bufio.NewReader(sqip)
+// mime types of cached placeholders
+var placeholderTypes = map[string]string{
+	".svg": "image/svg+xml",
+	".png": "image/png",
+	".jpg": "image/jpeg",
+}
+
+// GetEncodedPlaceholder returns placeholder file as data URI, blank when
+// there is none
+func GetEncodedPlaceholder(pathToPlaceholder string) string {
+	if pathToPlaceholder == "" {
+		return BlankPlaceholder
+	}
+	placeholder, err := os.Open(pathToPlaceholder)
+	if err != nil {
+		return BlankPlaceholder
+	}
+	defer placeholder.Close()
+	reader := bufio.NewReader(placeholder)
 	content, _ := ioutil.ReadAll(reader)
 	encoded := base64.StdEncoding.EncodeToString(content)
-	sqipTemp := "data:image/svg+xml;base64,%s"
-	return fmt.Sprintf(sqipTemp, encoded)
+	placeholderTemp := "data:%s;base64,%s"
+	return fmt.Sprintf(placeholderTemp, placeholderTypes[strings.ToLower(filepath.Ext(pathToPlaceholder))], encoded)
 }
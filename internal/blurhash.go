@@ -0,0 +1,174 @@
+package internal
+
+import (
+	"errors"
+	"image"
+	"math"
+	"strings"
+)
+
+// Port of https://github.com/woltapp/blurhash
+
+const base83 = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz#$%*+,-.:;=?@[]^_{|}~"
+
+func encode83(value, length int) string {
+	var b strings.Builder
+	for i := 1; i <= length; i++ {
+		digit := (value / int(math.Pow(83, float64(length-i)))) % 83
+		b.WriteByte(base83[digit])
+	}
+	return b.String()
+}
+
+func decode83(s string) (int, error) {
+	value := 0
+	for _, c := range s {
+		digit := strings.IndexRune(base83, c)
+		if digit < 0 {
+			return 0, errors.New("blurhash: invalid character")
+		}
+		value = value*83 + digit
+	}
+	return value, nil
+}
+
+func srgbToLinear(value uint8) float64 {
+	v := float64(value) / 255
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+func linearToSrgb(value float64) int {
+	v := math.Max(0, math.Min(1, value))
+	if v <= 0.0031308 {
+		return int(v*12.92*255 + 0.5)
+	}
+	return int((1.055*math.Pow(v, 1/2.4)-0.055)*255 + 0.5)
+}
+
+func signPow(value, exp float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(value), exp), value)
+}
+
+// encodeBlurHash of a small image with x by y components
+func encodeBlurHash(img *image.NRGBA, x, y int) string {
+	w, h := img.Bounds().Dx(), img.Bounds().Dy()
+	factors := make([][3]float64, 0, x*y)
+	for j := 0; j < y; j++ {
+		for i := 0; i < x; i++ {
+			normalisation := 2.0
+			if i == 0 && j == 0 {
+				normalisation = 1
+			}
+			var f [3]float64
+			for py := 0; py < h; py++ {
+				for px := 0; px < w; px++ {
+					basis := normalisation *
+						math.Cos(math.Pi*float64(i)*float64(px)/float64(w)) *
+						math.Cos(math.Pi*float64(j)*float64(py)/float64(h))
+					p := img.Pix[py*img.Stride+px*4:]
+					f[0] += basis * srgbToLinear(p[0])
+					f[1] += basis * srgbToLinear(p[1])
+					f[2] += basis * srgbToLinear(p[2])
+				}
+			}
+			scale := 1 / float64(w*h)
+			factors = append(factors, [3]float64{f[0] * scale, f[1] * scale, f[2] * scale})
+		}
+	}
+
+	hash := encode83((x-1)+(y-1)*9, 1)
+	dc, ac := factors[0], factors[1:]
+
+	maximumValue := 1.0
+	if len(ac) > 0 {
+		actualMax := 0.0
+		for _, f := range ac {
+			actualMax = math.Max(actualMax, math.Max(math.Abs(f[0]), math.Max(math.Abs(f[1]), math.Abs(f[2]))))
+		}
+		quantisedMax := int(math.Max(0, math.Min(82, math.Floor(actualMax*166-0.5))))
+		maximumValue = float64(quantisedMax+1) / 166
+		hash += encode83(quantisedMax, 1)
+	} else {
+		hash += encode83(0, 1)
+	}
+
+	hash += encode83(linearToSrgb(dc[0])<<16+linearToSrgb(dc[1])<<8+linearToSrgb(dc[2]), 4)
+	for _, f := range ac {
+		quant := func(v float64) int {
+			return int(math.Max(0, math.Min(18, math.Floor(signPow(v/maximumValue, 0.5)*9+9.5))))
+		}
+		hash += encode83(quant(f[0])*19*19+quant(f[1])*19+quant(f[2]), 2)
+	}
+
+	return hash
+}
+
+// decodeBlurHash renders a hash into an image of given size
+func decodeBlurHash(hash string, w, h int) (*image.NRGBA, error) {
+	if len(hash) < 6 {
+		return nil, errors.New("blurhash: invalid length")
+	}
+	sizeFlag, err := decode83(hash[:1])
+	if err != nil {
+		return nil, err
+	}
+	x, y := sizeFlag%9+1, sizeFlag/9+1
+	if len(hash) != 4+2*x*y {
+		return nil, errors.New("blurhash: invalid length")
+	}
+
+	quantisedMax, err := decode83(hash[1:2])
+	if err != nil {
+		return nil, err
+	}
+	maximumValue := float64(quantisedMax+1) / 166
+
+	colors := make([][3]float64, x*y)
+	for i := range colors {
+		if i == 0 {
+			value, err := decode83(hash[2:6])
+			if err != nil {
+				return nil, err
+			}
+			colors[i] = [3]float64{
+				srgbToLinear(uint8(value >> 16)),
+				srgbToLinear(uint8(value >> 8)),
+				srgbToLinear(uint8(value)),
+			}
+			continue
+		}
+		value, err := decode83(hash[4+i*2 : 6+i*2])
+		if err != nil {
+			return nil, err
+		}
+		colors[i] = [3]float64{
+			signPow(float64(value/(19*19)-9)/9, 2) * maximumValue,
+			signPow(float64(value/19%19-9)/9, 2) * maximumValue,
+			signPow(float64(value%19-9)/9, 2) * maximumValue,
+		}
+	}
+
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for py := 0; py < h; py++ {
+		for px := 0; px < w; px++ {
+			var c [3]float64
+			for j := 0; j < y; j++ {
+				for i := 0; i < x; i++ {
+					basis := math.Cos(math.Pi*float64(px*i)/float64(w)) *
+						math.Cos(math.Pi*float64(py*j)/float64(h))
+					color := colors[j*x+i]
+					c[0] += color[0] * basis
+					c[1] += color[1] * basis
+					c[2] += color[2] * basis
+				}
+			}
+			p := img.Pix[py*img.Stride+px*4:]
+			p[0], p[1], p[2], p[3] = uint8(linearToSrgb(c[0])), uint8(linearToSrgb(c[1])), uint8(linearToSrgb(c[2])), 255
+		}
+	}
+
+	return img, nil
+}
@@ -0,0 +1,118 @@
+package internal
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func solid(w, h int, c color.NRGBA) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.SetNRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+// assertColor compares channels within a tolerance, hashes are lossy
+func assertColor(t *testing.T, got, want color.NRGBA, tolerance int) {
+	t.Helper()
+	for i, c := range [][2]uint8{{got.R, want.R}, {got.G, want.G}, {got.B, want.B}, {got.A, want.A}} {
+		if d := int(c[0]) - int(c[1]); d < -tolerance || d > tolerance {
+			t.Errorf("channel %d of %v, want %v", i, got, want)
+			return
+		}
+	}
+}
+
+func TestBase83RoundTrip(t *testing.T) {
+	tests := []struct {
+		value, length int
+		want          string
+	}{
+		{0, 1, "0"},
+		{82, 1, "~"},
+		{83, 2, "10"},
+		{0x808080, 4, "Eyb["},
+	}
+	for _, tt := range tests {
+		got := encode83(tt.value, tt.length)
+		if got != tt.want {
+			t.Errorf("encode83(%d, %d) = %q, want %q", tt.value, tt.length, got, tt.want)
+		}
+		if v, err := decode83(got); err != nil || v != tt.value {
+			t.Errorf("decode83(%q) = %d, %v, want %d", got, v, err, tt.value)
+		}
+	}
+	if _, err := decode83("a!"); err == nil {
+		t.Error("decode83 accepted an invalid character")
+	}
+}
+
+func TestBlurHashSolid(t *testing.T) {
+	tests := []struct {
+		name string
+		x, y int
+		c    color.NRGBA
+	}{
+		{"gray 4x3", 4, 3, color.NRGBA{128, 128, 128, 255}},
+		{"red 3x4", 3, 4, color.NRGBA{200, 30, 40, 255}},
+		{"dc only", 1, 1, color.NRGBA{10, 120, 240, 255}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash := encodeBlurHash(solid(16, 12, tt.c), tt.x, tt.y)
+			if len(hash) != 4+2*tt.x*tt.y {
+				t.Fatalf("len(%q) = %d, want %d", hash, len(hash), 4+2*tt.x*tt.y)
+			}
+			// the average color is stored exactly
+			dc := encode83(int(tt.c.R)<<16|int(tt.c.G)<<8|int(tt.c.B), 4)
+			if hash[2:6] != dc {
+				t.Errorf("DC of %q = %q, want %q", hash, hash[2:6], dc)
+			}
+			img, err := decodeBlurHash(hash, 8, 6)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if img.Bounds().Dx() != 8 || img.Bounds().Dy() != 6 {
+				t.Errorf("size = %v, want 8x6", img.Bounds())
+			}
+			if tt.x*tt.y == 1 {
+				assertColor(t, img.NRGBAAt(0, 0), tt.c, 0)
+				assertColor(t, img.NRGBAAt(7, 5), tt.c, 0)
+			}
+		})
+	}
+}
+
+func TestBlurHashGradient(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 32, 8))
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 32; x++ {
+			v := uint8(x * 8)
+			img.SetNRGBA(x, y, color.NRGBA{v, v, v, 255})
+		}
+	}
+	decoded, err := decodeBlurHash(encodeBlurHash(img, 4, 3), 32, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// dark on the left, light on the right
+	if left, right := decoded.NRGBAAt(0, 4).R, decoded.NRGBAAt(31, 4).R; left+64 > right {
+		t.Errorf("left %d, right %d, want a horizontal gradient", left, right)
+	}
+}
+
+func TestDecodeBlurHashInvalid(t *testing.T) {
+	for name, hash := range map[string]string{
+		"short":        "LEHV6",
+		"wrong length": "LEHV6nWB2yk8pyo0adR*.7kCMdnjx",
+		"invalid char": "00!!!!",
+	} {
+		if _, err := decodeBlurHash(hash, 4, 4); err == nil {
+			t.Errorf("%s: decoded %q", name, hash)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package internal
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"image/color"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/disintegration/imaging"
+	"github.com/fogleman/gg"
+	"github.com/gobuffalo/packr/v2"
+	"github.com/golang/freetype/truetype"
+)
+
+// Card is an Open Graph / Twitter card image composed at export
+type Card struct {
+	Photo  string // background, cropped around its focal point
+	Avatar string
+	Name   string
+	Title  string
+	Custom bool // Photo is a ready made card, nothing is drawn over it
+}
+
+// size recommended by Facebook and Twitter for large cards
+const cardWidth, cardHeight = 1200, 630
+
+var cardCrop = crop{"card", 40, 21}
+
+// MakeCard renders a card into `.moul/cards` unless it is cached and returns
+// its URL, named after its content so social networks refetch changed cards
+func MakeCard(name string, c Card) (string, error) {
+	// paths are left out, the same site builds the same cards anywhere
+	hash := sha1.New()
+	fmt.Fprint(hash, c.Name, c.Title, c.Custom)
+	for _, p := range []string{c.Photo, c.Avatar} {
+		if p != "" {
+			fmt.Fprint(hash, GetSHA1(p), getFocus(p))
+		}
+	}
+	watermark := getWatermark()
+	if watermark.enabled() {
+		fmt.Fprintf(hash, "%+v", watermark)
+	}
+	url := path.Join("cards", name+"."+hex.EncodeToString(hash.Sum(nil))[:8]+".jpg")
+	out := filepath.Join(".moul", filepath.FromSlash(url))
+	if _, err := os.Stat(out); err == nil {
+		return url, nil
+	}
+
+	img, err := drawCard(c, watermark)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(out), 0755); err != nil {
+		return "", err
+	}
+	return url, imaging.Save(img, out, imaging.JPEGQuality(90))
+}
+
+// drawCard composes a card, its background is watermarked whatever the
+// watermarked sizes, cards are published copies of the photo too
+func drawCard(c Card, watermark WatermarkOptions) (image.Image, error) {
+	dc := gg.NewContext(cardWidth, cardHeight)
+	dc.SetHexColor("#1E2020")
+	dc.Clear()
+
+	if c.Photo != "" {
+		img, err := openImage(c.Photo, "srgb")
+		if err != nil {
+			return nil, err
+		}
+		img = cropAround(img, cardCrop, getFocus(c.Photo))
+		dc.DrawImage(imaging.Resize(img, cardWidth, cardHeight, imaging.Lanczos), 0, 0)
+
+		if !c.Custom {
+			// darkens the bottom of the photo to keep the text readable
+			shade := gg.NewLinearGradient(0, cardHeight*0.3, 0, cardHeight)
+			shade.AddColorStop(0, color.NRGBA{0, 0, 0, 0})
+			shade.AddColorStop(1, color.NRGBA{0, 0, 0, 192})
+			dc.SetFillStyle(shade)
+			dc.DrawRectangle(0, 0, cardWidth, cardHeight)
+			dc.Fill()
+		}
+		// drawn over the shade to stay as visible as on variants
+		if watermark.enabled() {
+			marked, err := drawWatermark(dc.Image(), watermark)
+			if err != nil {
+				return nil, err
+			}
+			dc.DrawImage(marked, 0, 0)
+		}
+		if c.Custom {
+			return dc.Image(), nil
+		}
+	}
+
+	bold, err := loadFont("JetBrainsMono-Bold.ttf")
+	if err != nil {
+		return nil, err
+	}
+	regular, err := loadFont("JetBrainsMono-Regular.ttf")
+	if err != nil {
+		return nil, err
+	}
+
+	const padding, avatarSize = 64, 88
+	x, y := float64(padding), float64(cardHeight-padding-avatarSize)
+	if c.Avatar != "" {
+		if img, err := openImage(c.Avatar, "srgb"); err == nil {
+			img = imaging.Fill(img, avatarSize, avatarSize, imaging.Center, imaging.Lanczos)
+			dc.DrawCircle(x+avatarSize/2, y+avatarSize/2, avatarSize/2)
+			dc.Clip()
+			dc.DrawImage(img, int(x), int(y))
+			dc.ResetClip()
+			x += avatarSize + 24
+		}
+	}
+	dc.SetHexColor("#ffffff")
+	dc.SetFontFace(truetype.NewFace(regular, &truetype.Options{Size: 32}))
+	dc.DrawStringAnchored(c.Name, x, y+avatarSize/2, 0, 0.35)
+
+	// at most three lines above the avatar, bottom up
+	dc.SetFontFace(truetype.NewFace(bold, &truetype.Options{Size: 52}))
+	lines := dc.WordWrap(c.Title, cardWidth-2*padding)
+	if len(lines) > 3 {
+		lines = lines[:3]
+		lines[2] = strings.TrimRight(lines[2], " .,;:") + "…"
+	}
+	baseline := y - 32
+	for i := len(lines) - 1; i >= 0; i-- {
+		dc.DrawString(lines[i], padding, baseline)
+		baseline -= dc.FontHeight() * 1.3
+	}
+
+	return dc.Image(), nil
+}
+
+// loadFont parses a font embedded in the assets box
+func loadFont(name string) (*truetype.Font, error) {
+	box := packr.New("assets", "../cmd/assets")
+	f, err := box.Find(name)
+	if err != nil {
+		return nil, err
+	}
+	return truetype.Parse(f)
+}
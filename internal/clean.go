@@ -0,0 +1,137 @@
+package internal
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/gosimple/slug"
+	"github.com/spf13/viper"
+)
+
+// Collect reconciles `.moul/manifest.toml` with targets and removes files
+// nothing refers to anymore. Cached photos whose source is gone are dropped
+// from the section of their prefix, the `photos` section listing published
+// files is rebuilt from the sections of targets and sections of directories
+// that are no longer exported are removed, as are `store` entries no photo
+// refers to. It returns removed files, nothing is changed on dry run. Stored
+// variants are kept as long as a cached photo refers to them.
+func Collect(targets []Target, dryRun bool) ([]string, error) {
+	photos := GetManifest("photos")
+	published := viper.New()
+	if photos.IsSet("cards") {
+		published.Set("cards", photos.GetStringSlice("cards"))
+	}
+
+	var stored []string
+	keys := map[string]bool{}
+	current := map[string]bool{}
+	manifests := map[string]*viper.Viper{}
+	for _, t := range targets {
+		prefix := slug.Make(t.Prefix)
+		current[prefix] = true
+
+		m := GetManifest(prefix)
+		kept := viper.New()
+		paths := []string{}
+		if _, err := os.Stat(t.Path); err == nil {
+			for _, photo := range GetPhotos(t.Path) {
+				fn := slug.Make(filepath.Base(photo))
+				if !m.IsSet(fn) {
+					continue
+				}
+				kept.Set(fn, m.Get(fn))
+				paths = append(paths, m.GetStringSlice(fn+".paths")...)
+				stored = append(stored, m.GetStringSlice(fn+".store")...)
+				keys[m.GetString(fn+".key")] = true
+			}
+		}
+		manifests[prefix] = kept
+		published.Set(prefix, paths)
+	}
+
+	stale := unreachable(published, stored)
+	if dryRun {
+		return stale, nil
+	}
+
+	store := GetManifest("store")
+	keptStore := viper.New()
+	for key := range keys {
+		if store.IsSet(key) {
+			keptStore.Set(key, store.Get(key))
+		}
+	}
+	if err := writeManifest(keptStore, "store"); err != nil {
+		return nil, err
+	}
+
+	for prefix, kept := range manifests {
+		if err := writeManifest(kept, prefix); err != nil {
+			return nil, err
+		}
+	}
+	for _, prefix := range photos.AllKeys() {
+		if prefix != "cards" && !current[prefix] {
+			if err := removeManifest(prefix); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := writeManifest(published, "photos"); err != nil {
+		return nil, err
+	}
+
+	return stale, removeFiles(stale)
+}
+
+// SetCards publishes card images along with photos
+func SetCards(urls []string) error {
+	photos := GetManifest("photos")
+	var paths []string
+	for _, url := range urls {
+		paths = append(paths, filepath.Join(".moul", filepath.FromSlash(url)))
+	}
+	photos.Set("cards", paths)
+	return writeManifest(photos, "photos")
+}
+
+// unreachable returns cached variants and cards that are not published, and
+// stored variants that are not referenced
+func unreachable(published *viper.Viper, stored []string) []string {
+	reachable := map[string]bool{}
+	for _, p := range stored {
+		reachable[filepath.Clean(p)] = true
+	}
+	for _, k := range published.AllKeys() {
+		for _, p := range published.GetStringSlice(k) {
+			reachable[filepath.Clean(p)] = true
+		}
+	}
+
+	var stale []string
+	for _, dir := range []string{"photos", "cards", "store"} {
+		filepath.Walk(filepath.Join(".moul", dir), func(path string, info os.FileInfo, err error) error {
+			if err == nil && !info.IsDir() && !reachable[path] {
+				stale = append(stale, path)
+			}
+			return nil
+		})
+	}
+	return stale
+}
+
+// removeFiles deletes cached files and the directories they leave empty
+func removeFiles(paths []string) error {
+	for _, path := range paths {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		// fails once a directory is not empty
+		for dir := filepath.Dir(path); filepath.Dir(dir) != ".moul" && dir != "."; dir = filepath.Dir(dir) {
+			if os.Remove(dir) != nil {
+				break
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/disintegration/imaging"
+)
+
+// DefaultColor is used until a photo has been processed
+const DefaultColor = "rgba(0, 0, 0, .93)"
+
+// GetAverageColor returns the average color of given photo as hex
+func GetAverageColor(inPath string) (string, error) {
+	src, err := openImage(inPath, "srgb")
+	if err != nil {
+		return "", err
+	}
+
+	// averaging a thumbnail is as good as every pixel and far cheaper,
+	// transparent pixels are weighted out
+	thumb := imaging.Resize(src, 32, 0, imaging.Box)
+	var r, g, b, n int
+	for i := 0; i < len(thumb.Pix); i += 4 {
+		a := int(thumb.Pix[i+3])
+		r += int(thumb.Pix[i]) * a
+		g += int(thumb.Pix[i+1]) * a
+		b += int(thumb.Pix[i+2]) * a
+		n += a
+	}
+	if n == 0 {
+		return DefaultColor, nil
+	}
+
+	return fmt.Sprintf("#%02x%02x%02x", r/n, g/n, b/n), nil
+}
+
+// parseHexColor returns components of `#rgb` or `#rrggbb` between 0 and 1,
+// white when the color cannot be read
+func parseHexColor(hex string) (float64, float64, float64) {
+	var r, g, b int
+	hex = strings.TrimPrefix(hex, "#")
+	switch len(hex) {
+	case 3:
+		if _, err := fmt.Sscanf(hex, "%1x%1x%1x", &r, &g, &b); err != nil {
+			return 1, 1, 1
+		}
+		r, g, b = r*17, g*17, b*17
+	case 6:
+		if _, err := fmt.Sscanf(hex, "%02x%02x%02x", &r, &g, &b); err != nil {
+			return 1, 1, 1
+		}
+	default:
+		return 1, 1, 1
+	}
+	return float64(r) / 255, float64(g) / 255, float64(b) / 255
+}
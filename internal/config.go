@@ -0,0 +1,77 @@
+package internal
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// GetConfig reads `moul.toml` with the image processing defaults applied
+func GetConfig() *viper.Viper {
+	config := viper.New()
+	config.SetConfigName("moul")
+	config.AddConfigPath(".")
+	config.SetDefault("image.formats", []string{"avif", "webp"})
+	config.SetDefault("image.sizes.cover", []int{2560, 1280, 620})
+	config.SetDefault("image.sizes.avatar", []int{512, 320})
+	config.SetDefault("image.sizes.collection", []int{2048, 750})
+	config.SetDefault("image.sizes.section", []int{2048, 750})
+	config.SetDefault("image.webp.quality", 85)
+	config.SetDefault("image.avif.quality", 65)
+	config.SetDefault("image.jpeg.quality", 95)
+	config.SetDefault("image.jpeg.progressive", false)
+	config.SetDefault("image.jpeg.subsampling", "4:2:0")
+	config.SetDefault("image.jpeg.optimize", false)
+	config.SetDefault("image.jpeg.target", 0)
+	config.SetDefault("image.jpeg.min_quality", 60)
+	config.SetDefault("image.placeholder", "sqip")
+	config.SetDefault("image.profile", "srgb")
+	config.SetDefault("image.alpha.mode", "auto")
+	config.SetDefault("image.alpha.background", "")
+	config.SetDefault("image.resample.filter", "lanczos")
+	config.SetDefault("image.resample.linear", false)
+	config.SetDefault("image.resample.radius", 0.6)
+	config.SetDefault("watermark.color", "#ffffff")
+	config.SetDefault("watermark.position", "bottom-right")
+	config.SetDefault("watermark.margin", 0.02)
+	config.SetDefault("watermark.opacity", 0.5)
+	config.SetDefault("watermark.scale", 0.2)
+	config.SetDefault("watermark.sizes", []int{})
+	config.SetDefault("exif.fields", exifFields)
+	config.SetDefault("privacy.keep", []string{"copyright", "artist"})
+	config.SetDefault("cache.shared", false)
+	config.SetDefault("cache.size", 2048)
+	config.ReadInConfig()
+
+	return config
+}
+
+// GetSizes returns output widths of given photo type, largest first.
+// Sections like `section-1` fall back to the shared `section` widths.
+func GetSizes(photoType string) []int {
+	config := GetConfig()
+	key := "image.sizes." + photoType
+	if !config.IsSet(key) && strings.HasPrefix(photoType, "section-") {
+		key = "image.sizes.section"
+	}
+
+	var sizes []int
+	for _, size := range config.GetIntSlice(key) {
+		if size > 0 && !containsSize(sizes, size) {
+			sizes = append(sizes, size)
+		}
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(sizes)))
+
+	return sizes
+}
+
+func containsSize(sizes []int, size int) bool {
+	for _, s := range sizes {
+		if s == size {
+			return true
+		}
+	}
+	return false
+}
@@ -59,6 +59,9 @@ func GetPhotoDev(dir, slugName string) string {
 	sectionPath := filepath.Join(".", "photos", dir)
 	if _, err := os.Stat(sectionPath); !os.IsNotExist(err) {
 		sectionPhotos := GetPhotos(sectionPath)
+		fields := GetConfig().GetStringSlice("exif.fields")
+		// colors are only known once exported, preview reuses them
+		cache := GetManifest(slug.Make(dir))
 		sc := []Collection{}
 		for _, p := range sectionPhotos {
 			widthHd, heightHd := GetPhotoDimension(p)
@@ -73,7 +76,8 @@ func GetPhotoDev(dir, slugName string) string {
 				HeightHd: heightHd,
 				Width:    750,
 				Height:   int(math.Round(height)),
-				Color:    "rgba(0, 0, 0, .93)",
+				Color:    getColor(cache, slug.Make(fn)),
+				Exif:     NewExif(getShootingData(p), fields),
 			})
 		}
 		scj, _ := json.Marshal(sc)
@@ -86,13 +90,9 @@ func GetPhotoDev(dir, slugName string) string {
 func GetPhotoProd(dir, slugName string) string {
 	sectionPath := filepath.Join(".", "photos", dir)
 	if _, err := os.Stat(sectionPath); !os.IsNotExist(err) {
-		Resize(sectionPath, slugName, slug.Make(dir), []int{2048, 750})
-		config := viper.New()
-		config.AddConfigPath(".moul")
-		config.SetConfigType("toml")
-		config.SetConfigName(slug.Make(dir))
-		config.ReadInConfig()
+		config := GetManifest(slug.Make(dir))
 		sectionPhotos := GetPhotos(sectionPath)
+		fields := GetConfig().GetStringSlice("exif.fields")
 		sc := []Collection{}
 
 		for _, photo := range sectionPhotos {
@@ -100,20 +100,42 @@ func GetPhotoProd(dir, slugName string) string {
 			name := GetFileName(fn, slugName)
 			fnName := strings.ToLower(strings.TrimSuffix(fn, filepath.Ext(fn)))
 			pid := config.GetString(slug.Make(fn) + ".id")
+			sizes := config.GetIntSlice(slug.Make(fn) + ".sizes")
+			if len(sizes) == 0 {
+				continue
+			}
+			hd, thumb := sizes[0], sizes[len(sizes)-1]
+			fallback := GetFallback(config, slug.Make(fn))
 			widthHd, heightHd := GetPhotoDimension(
-				filepath.Join(".moul", "photos", pid, slug.Make(dir), "2048", name+".jpg"),
+				filepath.Join(getFilePath(pid, slug.Make(dir), hd), name+"."+fallback),
 			)
 			width, height := GetPhotoDimension(
-				filepath.Join(".moul", "photos", pid, slug.Make(dir), "750", name+".jpg"),
+				filepath.Join(getFilePath(pid, slug.Make(dir), thumb), name+"."+fallback),
+			)
+			var sources []Source
+			for _, format := range config.GetStringSlice(slug.Make(fn) + ".formats") {
+				sources = append(sources, Source{
+					Type:   ImageType(format),
+					Srcset: GetSrcset(pid, slug.Make(dir), name, format, sizes),
+				})
+			}
+			placeholder := GetPlaceholderURL(pid, slug.Make(dir), name,
+				config.GetString(slug.Make(fn)+".placeholder"),
 			)
 			sc = append(sc, Collection{
-				ID:       pid,
-				Name:     fnName,
-				WidthHd:  widthHd,
-				HeightHd: heightHd,
-				Width:    width,
-				Height:   height,
-				Color:    "rgba(0, 0, 0, .93)",
+				ID:          pid,
+				Name:        fnName,
+				Src:         GetPhotoURL(pid, slug.Make(dir), name, fallback, thumb),
+				SrcHd:       GetPhotoURL(pid, slug.Make(dir), name, fallback, hd),
+				Srcset:      GetSrcset(pid, slug.Make(dir), name, fallback, sizes),
+				WidthHd:     widthHd,
+				HeightHd:    heightHd,
+				Width:       width,
+				Height:      height,
+				Color:       getColor(config, slug.Make(fn)),
+				Placeholder: placeholder,
+				Sources:     sources,
+				Exif:        NewExif(config.GetStringMapString(slug.Make(fn)+".exif"), fields),
 			})
 		}
 		scj, _ := json.Marshal(sc)
@@ -121,3 +143,11 @@ func GetPhotoProd(dir, slugName string) string {
 	}
 	return ""
 }
+
+// cached color of a photo
+func getColor(config *viper.Viper, fn string) string {
+	if color := config.GetString(fn + ".color"); color != "" {
+		return color
+	}
+	return DefaultColor
+}
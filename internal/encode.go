@@ -0,0 +1,297 @@
+package internal
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strconv"
+	"sync"
+
+	"github.com/disintegration/imaging"
+	"github.com/fatih/color"
+)
+
+// modern formats are encoded by external tools, JPEG is always written
+var encoders = map[string]string{
+	"avif": "avifenc",
+	"webp": "cwebp",
+}
+
+var mimeTypes = map[string]string{
+	"avif": "image/avif",
+	"webp": "image/webp",
+	"jpg":  "image/jpeg",
+	"png":  "image/png",
+}
+
+var missingEncoder sync.Map
+
+var (
+	avifProbe sync.Once
+	avifFlag  string
+)
+
+// avifQualityFlag returns how the installed `avifenc` takes quality,
+// `--qcolor` or `--min` with `--max` before libavif 1.0, empty when it takes
+// neither. It is probed once.
+func avifQualityFlag() string {
+	avifProbe.Do(func() {
+		// some builds exit with an error after printing usage
+		out, _ := exec.Command(encoders["avif"], "--help").CombinedOutput()
+		switch {
+		case bytes.Contains(out, []byte("--qcolor")):
+			avifFlag = "--qcolor"
+		case bytes.Contains(out, []byte("--min")):
+			avifFlag = "--min"
+		}
+	})
+	return avifFlag
+}
+
+var qualityWarned sync.Map
+
+// getQuality reads `quality` of `[image.webp]` and `[image.avif]`, from 1
+// to 100 like JPEG. Configured formats are listed whether their encoder is
+// installed or not, every machine gets the same photo IDs.
+func getQuality() map[string]int {
+	config := GetConfig()
+	quality := map[string]int{}
+	for _, format := range config.GetStringSlice("image.formats") {
+		if _, ok := encoders[format]; !ok {
+			continue
+		}
+		key := "image." + format + ".quality"
+		q := config.GetInt(key)
+		if q < 1 || q > 100 {
+			if _, warned := qualityWarned.LoadOrStore(key, true); !warned {
+				color.Yellow("Invalid `%s` value `%d`, expected 1 to 100", key, q)
+			}
+			q = clampInt(q, 1, 100)
+		}
+		quality[format] = q
+	}
+	return quality
+}
+
+// ImageType returns the MIME type of given format
+func ImageType(format string) string {
+	return mimeTypes[format]
+}
+
+// GetFormats returns configured formats that can be encoded on this machine
+func GetFormats() []string {
+	var formats []string
+	for _, format := range GetConfig().GetStringSlice("image.formats") {
+		encoder, ok := encoders[format]
+		if !ok {
+			continue
+		}
+		if _, err := exec.LookPath(encoder); err != nil {
+			if _, warned := missingEncoder.LoadOrStore(format, true); !warned {
+				color.Yellow("Skipped `%s`: `%s` is not found in PATH", format, encoder)
+			}
+			continue
+		}
+		if format == "avif" && avifQualityFlag() == "" {
+			if _, warned := missingEncoder.LoadOrStore(format, true); !warned {
+				color.Yellow("Skipped `%s`: `%s` accepts neither `--qcolor` nor `--min`", format, encoder)
+			}
+			continue
+		}
+		formats = append(formats, format)
+	}
+
+	return formats
+}
+
+// encoderParams returns a fingerprint of the installed encoder of a format
+// and its quality, a format that failed on a source is retried once it
+// changes
+func encoderParams(format string, quality int) string {
+	path, _ := exec.LookPath(encoders[format])
+	stamp := path
+	if info, err := os.Stat(path); err == nil {
+		stamp += fmt.Sprintf(" %d %d", info.Size(), info.ModTime().UnixNano())
+	}
+	if format == "avif" {
+		stamp += " " + avifQualityFlag()
+	}
+	hash := sha1.Sum([]byte(fmt.Sprintf("%s %d", stamp, quality)))
+	return hex.EncodeToString(hash[:])[:20]
+}
+
+// encode resized image to given format and quality next to its fallback,
+// icc and EXIF kept by `privacy.keep` are carried over when not nil
+func encode(img image.Image, format, out string, quality int, icc []byte, e *exif, lossless bool) error {
+	tmp, err := ioutil.TempFile("", "moul-*.png")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	var buf bytes.Buffer
+	enc := png.Encoder{CompressionLevel: png.BestSpeed}
+	if err := enc.Encode(&buf, img); err != nil {
+		tmp.Close()
+		return err
+	}
+	if icc != nil {
+		err = writePNGICC(tmp, buf.Bytes(), icc)
+	} else {
+		_, err = tmp.Write(buf.Bytes())
+	}
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	args := []string{tmp.Name(), out}
+	if format == "avif" {
+		if avifQualityFlag() == "--min" {
+			// quantizers go from 0, lossless, to 63
+			q := strconv.Itoa((100 - quality) * 63 / 100)
+			args = append([]string{"--min", q, "--max", q}, args...)
+		} else {
+			args = append([]string{"--qcolor", strconv.Itoa(quality)}, args...)
+		}
+	}
+	if format == "avif" && e != nil {
+		data, err := ioutil.TempFile("", "moul-*.exif")
+		if err != nil {
+			return err
+		}
+		defer os.Remove(data.Name())
+		_, err = data.Write(e.encode())
+		if cerr := data.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return err
+		}
+		args = append([]string{"--exif", data.Name()}, args...)
+	}
+	if format == "webp" {
+		args = []string{"-quiet", tmp.Name(), "-o", out}
+		if icc != nil {
+			args = append([]string{"-metadata", "icc"}, args...)
+		}
+		if lossless {
+			args = append([]string{"-lossless"}, args...)
+		} else {
+			args = append([]string{"-q", strconv.Itoa(quality)}, args...)
+		}
+	}
+
+	if err := exec.Command(encoders[format], args...).Run(); err != nil {
+		return err
+	}
+	// PNG input carries no EXIF `cwebp` reads
+	if format == "webp" && e != nil {
+		return writeWebPExif(out, e)
+	}
+	return nil
+}
+
+// savePNG writes the fallback of lossless variants, icc and EXIF are
+// embedded when not nil
+func savePNG(img image.Image, out string, icc []byte, e *exif) error {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return err
+	}
+	data := buf.Bytes()
+	if e != nil {
+		var err error
+		if data, err = insertPNGChunk(data, "eXIf", e.encode()); err != nil {
+			return err
+		}
+	}
+	f, err := os.Create(out)
+	if err != nil {
+		return err
+	}
+	if icc != nil {
+		err = writePNGICC(f, data, icc)
+	} else {
+		_, err = f.Write(data)
+	}
+	if err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// usesCJPEG reports whether JPEG variants are encoded by `cjpeg`. Go's
+// encoder only supports quality, other options need `cjpeg` from
+// libjpeg-turbo or mozjpeg, getJPEG resets them when it is missing.
+func usesCJPEG(opts JPEGOptions) bool {
+	return opts.Progressive || opts.Optimize || opts.Subsampling == "4:4:4"
+}
+
+// saveJPEG writes the JPEG variant with the encoder usesCJPEG selects
+func saveJPEG(img image.Image, out string, opts JPEGOptions) error {
+	if !usesCJPEG(opts) {
+		return imaging.Save(img, out, imaging.JPEGQuality(opts.Quality))
+	}
+
+	tmp, err := ioutil.TempFile("", "moul-*.ppm")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := writePPM(tmp, img); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	sample := "2x2"
+	if opts.Subsampling == "4:4:4" {
+		sample = "1x1"
+	}
+	args := []string{"-quality", strconv.Itoa(opts.Quality), "-sample", sample}
+	if opts.Progressive {
+		args = append(args, "-progressive")
+	}
+	if opts.Optimize {
+		args = append(args, "-optimize")
+	}
+	args = append(args, "-outfile", out, tmp.Name())
+
+	return exec.Command("cjpeg", args...).Run()
+}
+
+// writePPM writes binary PPM which every `cjpeg` build can read
+func writePPM(f *os.File, img image.Image) error {
+	src := imaging.Clone(img)
+	w := bufio.NewWriter(f)
+	fmt.Fprintf(w, "P6\n%d %d\n255\n", src.Bounds().Dx(), src.Bounds().Dy())
+	for i := 0; i < len(src.Pix); i += 4 {
+		w.Write(src.Pix[i : i+3])
+	}
+
+	return w.Flush()
+}
+
+func containsFormat(formats []string, format string) bool {
+	for _, f := range formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,422 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	tagMake             = 0x010f
+	tagModel            = 0x0110
+	tagOrientation      = 0x0112
+	tagExposureTime     = 0x829a
+	tagFNumber          = 0x829d
+	tagExifIFD          = 0x8769
+	tagGPSIFD           = 0x8825
+	tagISO              = 0x8827
+	tagDateTimeOriginal = 0x9003
+	tagFocalLength      = 0x920a
+	tagLensModel        = 0xa434
+)
+
+// shooting data keys in display order
+var exifFields = []string{
+	"camera", "lens", "focal_length", "aperture", "shutter_speed", "iso", "date",
+}
+
+// Exif struct
+type Exif struct {
+	Camera       string `json:"camera,omitempty"`
+	Lens         string `json:"lens,omitempty"`
+	FocalLength  string `json:"focal_length,omitempty"`
+	Aperture     string `json:"aperture,omitempty"`
+	ShutterSpeed string `json:"shutter_speed,omitempty"`
+	ISO          string `json:"iso,omitempty"`
+	Date         string `json:"date,omitempty"`
+}
+
+// exifTag is a raw TIFF directory entry
+type exifTag struct {
+	typ   uint16
+	count uint32
+	value []byte
+}
+
+// exif holds tags of IFD0, the Exif and the GPS sub-directories
+type exif struct {
+	order binary.ByteOrder
+	ifd0  map[uint16]exifTag
+	sub   map[uint16]exifTag
+	gps   map[uint16]exifTag
+}
+
+var errNoExif = errors.New("exif: not found")
+
+// read EXIF of a photo, TIFF files are an EXIF structure themselves
+func readExif(path string) (*exif, error) {
+	format := sniffFormat(path)
+	if format == "jpeg" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		payload, err := findExifSegment(f)
+		if err != nil {
+			return nil, err
+		}
+		return parseTIFF(payload)
+	}
+
+	var payload []byte
+	switch format {
+	case "tiff", "png", "webp":
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		switch format {
+		case "tiff":
+			payload = data
+		case "png":
+			payload = pngChunk(data, "eXIf")
+		case "webp":
+			payload = bytes.TrimPrefix(webpChunk(data, "EXIF"), []byte("Exif\x00\x00"))
+		}
+	}
+	if payload == nil {
+		return nil, errNoExif
+	}
+	return parseTIFF(payload)
+}
+
+// findExifSegment returns the TIFF payload of the JPEG APP1 Exif segment
+func findExifSegment(r io.Reader) ([]byte, error) {
+	var soi uint16
+	if err := binary.Read(r, binary.BigEndian, &soi); err != nil || soi != 0xffd8 {
+		return nil, errNoExif
+	}
+
+	for {
+		var marker, size uint16
+		if err := binary.Read(r, binary.BigEndian, &marker); err != nil {
+			return nil, errNoExif
+		}
+		// start of scan, no metadata after this point
+		if marker>>8 != 0xff || marker == 0xffda {
+			return nil, errNoExif
+		}
+		if err := binary.Read(r, binary.BigEndian, &size); err != nil || size < 2 {
+			return nil, errNoExif
+		}
+		if marker != 0xffe1 {
+			if _, err := io.CopyN(ioutil.Discard, r, int64(size-2)); err != nil {
+				return nil, errNoExif
+			}
+			continue
+		}
+
+		segment := make([]byte, size-2)
+		if _, err := io.ReadFull(r, segment); err != nil {
+			return nil, errNoExif
+		}
+		// APP1 is shared with XMP
+		if bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return segment[6:], nil
+		}
+	}
+}
+
+// parseTIFF reads IFD0 and its sub-directories of a TIFF structure
+func parseTIFF(data []byte) (*exif, error) {
+	if len(data) < 8 {
+		return nil, errNoExif
+	}
+
+	e := &exif{}
+	switch string(data[:4]) {
+	case "II*\x00":
+		e.order = binary.LittleEndian
+	case "MM\x00*":
+		e.order = binary.BigEndian
+	default:
+		return nil, errNoExif
+	}
+
+	var err error
+	if e.ifd0, err = e.readIFD(data, e.order.Uint32(data[4:8])); err != nil {
+		return nil, err
+	}
+	e.sub, e.gps = map[uint16]exifTag{}, map[uint16]exifTag{}
+	if offset, ok := e.uint(tagExifIFD); ok {
+		e.sub, _ = e.readIFD(data, uint32(offset))
+	}
+	if tag, ok := e.ifd0[tagGPSIFD]; ok && tag.typ == 4 {
+		e.gps, _ = e.readIFD(data, e.order.Uint32(tag.value))
+	}
+
+	return e, nil
+}
+
+// size in bytes of each TIFF type
+var tiffTypeSize = map[uint16]uint32{
+	1: 1, 2: 1, 3: 2, 4: 4, 5: 8, 7: 1, 9: 4, 10: 8,
+}
+
+func (e *exif) readIFD(data []byte, offset uint32) (map[uint16]exifTag, error) {
+	tags := map[uint16]exifTag{}
+	if int(offset)+2 > len(data) {
+		return tags, errNoExif
+	}
+	count := int(e.order.Uint16(data[offset:]))
+	for i := 0; i < count; i++ {
+		entry := int(offset) + 2 + i*12
+		if entry+12 > len(data) {
+			return tags, errNoExif
+		}
+		tag := exifTag{
+			typ:   e.order.Uint16(data[entry+2:]),
+			count: e.order.Uint32(data[entry+4:]),
+		}
+		size := tiffTypeSize[tag.typ] * tag.count
+		if size == 0 {
+			continue
+		}
+		// values larger than 4 bytes are stored at an offset
+		value := data[entry+8 : entry+12]
+		if size > 4 {
+			start := e.order.Uint32(value)
+			if uint64(start)+uint64(size) > uint64(len(data)) {
+				continue
+			}
+			value = data[start : start+size]
+		}
+		tag.value = value[:size]
+		tags[e.order.Uint16(data[entry:])] = tag
+	}
+
+	return tags, nil
+}
+
+// tag looks up IFD0 first, then the Exif sub-directory
+func (e *exif) tag(id uint16) (exifTag, bool) {
+	if tag, ok := e.ifd0[id]; ok {
+		return tag, true
+	}
+	tag, ok := e.sub[id]
+	return tag, ok
+}
+
+// uint returns the first value of a BYTE, SHORT or LONG tag
+func (e *exif) uint(id uint16) (int, bool) {
+	tag, ok := e.tag(id)
+	if !ok {
+		return 0, false
+	}
+
+	switch tag.typ {
+	case 1, 7:
+		return int(tag.value[0]), true
+	case 3:
+		return int(e.order.Uint16(tag.value)), true
+	case 4, 9:
+		return int(e.order.Uint32(tag.value)), true
+	}
+	return 0, false
+}
+
+// getOrientation returns EXIF orientation of given photo, 1 if unknown
+func getOrientation(path string) int {
+	e, err := readExif(path)
+	if err != nil {
+		return 1
+	}
+	if orientation, ok := e.uint(tagOrientation); ok && orientation >= 1 && orientation <= 8 {
+		return orientation
+	}
+	return 1
+}
+
+// string returns the value of an ASCII tag
+func (e *exif) string(id uint16) string {
+	tag, ok := e.tag(id)
+	if !ok || tag.typ != 2 {
+		return ""
+	}
+	return strings.TrimSpace(strings.TrimRight(string(tag.value), "\x00"))
+}
+
+// rational returns the first value of a RATIONAL tag
+func (e *exif) rational(id uint16) (float64, bool) {
+	tag, ok := e.tag(id)
+	if !ok || (tag.typ != 5 && tag.typ != 10) {
+		return 0, false
+	}
+	num, den := e.order.Uint32(tag.value), e.order.Uint32(tag.value[4:])
+	if den == 0 {
+		return 0, false
+	}
+	if tag.typ == 10 {
+		return float64(int32(num)) / float64(int32(den)), true
+	}
+	return float64(num) / float64(den), true
+}
+
+// getShootingData returns formatted shooting data keyed by exifFields
+func getShootingData(path string) map[string]string {
+	data := map[string]string{}
+	e, err := readExif(path)
+	if err != nil {
+		return data
+	}
+
+	maker, model := e.string(tagMake), e.string(tagModel)
+	if strings.HasPrefix(strings.ToLower(model), strings.ToLower(maker)) {
+		maker = ""
+	}
+	data["camera"] = strings.TrimSpace(maker + " " + model)
+	data["lens"] = e.string(tagLensModel)
+	if focal, ok := e.rational(tagFocalLength); ok && focal > 0 {
+		data["focal_length"] = fmt.Sprintf("%gmm", math.Round(focal*10)/10)
+	}
+	if fnumber, ok := e.rational(tagFNumber); ok && fnumber > 0 {
+		data["aperture"] = fmt.Sprintf("f/%g", math.Round(fnumber*10)/10)
+	}
+	if exposure, ok := e.rational(tagExposureTime); ok && exposure > 0 {
+		if exposure < 1 {
+			data["shutter_speed"] = fmt.Sprintf("1/%gs", math.Round(1/exposure))
+		} else {
+			data["shutter_speed"] = fmt.Sprintf("%gs", math.Round(exposure*10)/10)
+		}
+	}
+	if iso, ok := e.uint(tagISO); ok && iso > 0 {
+		data["iso"] = fmt.Sprintf("ISO %d", iso)
+	}
+	if date, err := time.Parse("2006:01:02 15:04:05", e.string(tagDateTimeOriginal)); err == nil {
+		data["date"] = date.Format("2006-01-02")
+	}
+
+	for k, v := range data {
+		if v == "" {
+			delete(data, k)
+		}
+	}
+	return data
+}
+
+// NewExif keeps the shooting data of given fields, nil if none is left
+func NewExif(data map[string]string, fields []string) *Exif {
+	e := &Exif{}
+	values := map[string]*string{
+		"camera":        &e.Camera,
+		"lens":          &e.Lens,
+		"focal_length":  &e.FocalLength,
+		"aperture":      &e.Aperture,
+		"shutter_speed": &e.ShutterSpeed,
+		"iso":           &e.ISO,
+		"date":          &e.Date,
+	}
+
+	found := false
+	for _, field := range fields {
+		if v, ok := values[field]; ok && data[field] != "" {
+			*v = data[field]
+			found = true
+		}
+	}
+	if !found {
+		return nil
+	}
+	return e
+}
+
+// encode writes the directories back into a TIFF structure, keeping the
+// original byte order so values can be copied as is
+func (e *exif) encode() []byte {
+	ifd0 := map[uint16]exifTag{}
+	for id, tag := range e.ifd0 {
+		if id != tagExifIFD && id != tagGPSIFD {
+			ifd0[id] = tag
+		}
+	}
+	pointer := exifTag{typ: 4, count: 1, value: make([]byte, 4)}
+	if len(e.sub) > 0 {
+		ifd0[tagExifIFD] = pointer
+	}
+	if len(e.gps) > 0 {
+		ifd0[tagGPSIFD] = pointer
+	}
+
+	ifdSize := func(tags map[uint16]exifTag) uint32 {
+		if len(tags) == 0 {
+			return 0
+		}
+		return uint32(2 + 12*len(tags) + 4)
+	}
+	subOffset := 8 + ifdSize(ifd0)
+	gpsOffset := subOffset + ifdSize(e.sub)
+	dataOffset := gpsOffset + ifdSize(e.gps)
+
+	var ifds, data bytes.Buffer
+	writeIFD := func(tags map[uint16]exifTag) {
+		if len(tags) == 0 {
+			return
+		}
+		ids := make([]int, 0, len(tags))
+		for id := range tags {
+			ids = append(ids, int(id))
+		}
+		sort.Ints(ids)
+
+		binary.Write(&ifds, e.order, uint16(len(ids)))
+		for _, id := range ids {
+			tag := tags[uint16(id)]
+			binary.Write(&ifds, e.order, uint16(id))
+			binary.Write(&ifds, e.order, tag.typ)
+			binary.Write(&ifds, e.order, tag.count)
+
+			switch {
+			case uint16(id) == tagExifIFD:
+				binary.Write(&ifds, e.order, subOffset)
+			case uint16(id) == tagGPSIFD:
+				binary.Write(&ifds, e.order, gpsOffset)
+			case len(tag.value) <= 4:
+				ifds.Write(tag.value)
+				ifds.Write(make([]byte, 4-len(tag.value)))
+			default:
+				binary.Write(&ifds, e.order, dataOffset+uint32(data.Len()))
+				data.Write(tag.value)
+				// values start on a word boundary
+				if data.Len()%2 == 1 {
+					data.WriteByte(0)
+				}
+			}
+		}
+		binary.Write(&ifds, e.order, uint32(0))
+	}
+	writeIFD(ifd0)
+	writeIFD(e.sub)
+	writeIFD(e.gps)
+
+	var out bytes.Buffer
+	if e.order == binary.LittleEndian {
+		out.WriteString("II*\x00")
+	} else {
+		out.WriteString("MM\x00*")
+	}
+	binary.Write(&out, e.order, uint32(8))
+	out.Write(ifds.Bytes())
+	out.Write(data.Bytes())
+
+	return out.Bytes()
+}
@@ -0,0 +1,175 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/disintegration/imaging"
+)
+
+func ascii(s string) exifTag {
+	return exifTag{typ: 2, count: uint32(len(s) + 1), value: append([]byte(s), 0)}
+}
+
+func rationalTag(order binary.ByteOrder, num, den uint32) exifTag {
+	value := make([]byte, 8)
+	order.PutUint32(value, num)
+	order.PutUint32(value[4:], den)
+	return exifTag{typ: 5, count: 1, value: value}
+}
+
+func testExif(order binary.ByteOrder) *exif {
+	short := make([]byte, 2)
+	order.PutUint16(short, 400)
+	return &exif{
+		order: order,
+		ifd0: map[uint16]exifTag{
+			0x8298:  ascii("© Jane Doe, all rights reserved"),
+			0x013b:  ascii("Ja"),
+			tagMake: ascii("Camera maker"),
+		},
+		sub: map[uint16]exifTag{
+			tagISO:          {typ: 3, count: 1, value: short},
+			tagFNumber:      rationalTag(order, 28, 10),
+			tagExposureTime: rationalTag(order, 1, 250),
+			tagLensModel:    ascii("A lens"),
+		},
+		gps: map[uint16]exifTag{
+			0x0002: {typ: 5, count: 3, value: bytes.Repeat(rationalTag(order, 48, 1).value, 3)},
+		},
+	}
+}
+
+// assertExif compares directories, pointers to sub-directories are only
+// written by encode
+func assertExif(t *testing.T, want, got *exif) {
+	t.Helper()
+	ifd0 := map[uint16]exifTag{}
+	for id, tag := range got.ifd0 {
+		if id != tagExifIFD && id != tagGPSIFD {
+			ifd0[id] = tag
+		}
+	}
+	if got.order != want.order {
+		t.Errorf("order = %v, want %v", got.order, want.order)
+	}
+	for name, dirs := range map[string][2]map[uint16]exifTag{
+		"ifd0": {want.ifd0, ifd0},
+		"sub":  {want.sub, got.sub},
+		"gps":  {want.gps, got.gps},
+	} {
+		if !reflect.DeepEqual(dirs[0], dirs[1]) {
+			t.Errorf("%s = %v, want %v", name, dirs[1], dirs[0])
+		}
+	}
+}
+
+func TestExifEncodeRoundTrip(t *testing.T) {
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		t.Run(order.String(), func(t *testing.T) {
+			want := testExif(order)
+			got, err := parseTIFF(want.encode())
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertExif(t, want, got)
+		})
+	}
+}
+
+func TestExifEncodeWithoutSubDirectories(t *testing.T) {
+	want := &exif{
+		order: binary.LittleEndian,
+		ifd0:  map[uint16]exifTag{0x8298: ascii("Jane Doe")},
+		sub:   map[uint16]exifTag{},
+		gps:   map[uint16]exifTag{},
+	}
+	got, err := parseTIFF(want.encode())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := got.ifd0[tagGPSIFD]; ok {
+		t.Error("GPS pointer written without GPS tags")
+	}
+	assertExif(t, want, got)
+}
+
+func TestWriteExifRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "moul-exif")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	img := image.NewNRGBA(image.Rect(0, 0, 16, 8))
+	want := testExif(binary.BigEndian)
+
+	path := filepath.Join(dir, "variant.jpg")
+	if err := imaging.Save(img, path); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeExif(path, want); err != nil {
+		t.Fatal(err)
+	}
+	got, err := readExif(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertExif(t, want, got)
+	if err := verifyFile(path); err != nil {
+		t.Errorf("verifyFile: %v", err)
+	}
+
+	path = filepath.Join(dir, "variant.png")
+	if err := savePNG(img, path, nil, want); err != nil {
+		t.Fatal(err)
+	}
+	if err := verifyFile(path); err != nil {
+		t.Errorf("verifyFile: %v", err)
+	}
+	data, _ := ioutil.ReadFile(path)
+	if got, err := parseTIFF(pngChunk(data, "eXIf")); err != nil {
+		t.Errorf("png: %v", err)
+	} else {
+		assertExif(t, want, got)
+	}
+}
+
+func TestWriteWebPExif(t *testing.T) {
+	dir, err := ioutil.TempDir("", "moul-exif")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// 1×1 lossless WebP with alpha
+	simple := []byte("RIFF\x1a\x00\x00\x00WEBPVP8L\x0d\x00\x00\x00\x2f\x00\x00\x00\x10\x07\x10\x11\x11\x88\x88\xfe\x07\x00")
+	path := filepath.Join(dir, "variant.webp")
+	if err := ioutil.WriteFile(path, simple, 0644); err != nil {
+		t.Fatal(err)
+	}
+	want := testExif(binary.LittleEndian)
+	if err := writeWebPExif(path, want); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := verifyFile(path); err != nil {
+		t.Fatalf("verifyFile: %v", err)
+	}
+	data, _ := ioutil.ReadFile(path)
+	vp8x := webpChunk(data, "VP8X")
+	if len(vp8x) != 10 || vp8x[0] != 0x18 {
+		t.Errorf("VP8X = %x, want EXIF and alpha flags", vp8x)
+	}
+	got, err := parseTIFF(webpChunk(data, "EXIF"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertExif(t, want, got)
+}
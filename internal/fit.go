@@ -0,0 +1,97 @@
+package internal
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/fatih/color"
+)
+
+var fitWarned sync.Map
+
+// GetFit returns how output sizes of given photo type are measured, `width`,
+// `long-edge` or a bounding box ratio such as `3:2`. Sections like
+// `section-1` fall back to the shared `section` fit.
+func GetFit(photoType string) string {
+	config := GetConfig()
+	key := "image.fit." + photoType
+	if !config.IsSet(key) && strings.HasPrefix(photoType, "section-") {
+		key = "image.fit.section"
+	}
+
+	fit := config.GetString(key)
+	if _, _, ok := parseRatio(fit); ok || fit == "width" || fit == "long-edge" {
+		return fit
+	}
+	if fit != "" {
+		if _, warned := fitWarned.LoadOrStore(key, true); !warned {
+			color.Yellow("Unknown fit `%s` in `%s`, using `width`", fit, key)
+		}
+	}
+	return "width"
+}
+
+func parseRatio(s string) (int, int, bool) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+	w, err := strconv.Atoi(parts[0])
+	if err != nil || w <= 0 {
+		return 0, 0, false
+	}
+	h, err := strconv.Atoi(parts[1])
+	if err != nil || h <= 0 {
+		return 0, 0, false
+	}
+	return w, h, true
+}
+
+// fitScale returns the scale of a w×h photo fitting given size
+func fitScale(size, w, h int, fit string) float64 {
+	scale := float64(size) / float64(w)
+	switch fit {
+	case "width":
+	case "long-edge":
+		scale = math.Min(scale, float64(size)/float64(h))
+	default:
+		rw, rh, _ := parseRatio(fit)
+		scale = math.Min(scale, float64(size)*float64(rh)/float64(rw)/float64(h))
+	}
+	return scale
+}
+
+// fitWidth returns the width of a w×h photo resized to fit given size, never
+// larger than the photo. Sizes are kept when dimensions are unknown.
+func fitWidth(size, w, h int, fit string) int {
+	if w <= 0 || h <= 0 {
+		return size
+	}
+	scale := math.Min(1, fitScale(size, w, h, fit))
+	if width := int(float64(w)*scale + 0.5); width > 1 {
+		return width
+	}
+	return 1
+}
+
+// fitSizes returns the widths of the variants of a w×h photo, deduplicated,
+// along with the size each was requested as
+func fitSizes(sizes []int, w, h int, fit string) (widths, requested []int) {
+	for _, size := range sizes {
+		width := fitWidth(size, w, h, fit)
+		if !containsSize(widths, width) {
+			widths = append(widths, width)
+			requested = append(requested, size)
+		}
+	}
+	return widths, requested
+}
+
+// IsUndersized reports whether a photo is too small to fit given size, it
+// is not upscaled
+func IsUndersized(path string, size int, fit string) bool {
+	w, h, _ := photoDimension(path)
+	return w > 0 && h > 0 && fitScale(size, w, h, fit) > 1
+}
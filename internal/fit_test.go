@@ -0,0 +1,83 @@
+package internal
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseRatio(t *testing.T) {
+	tests := []struct {
+		in   string
+		w, h int
+		ok   bool
+	}{
+		{"3:2", 3, 2, true},
+		{"16:9", 16, 9, true},
+		{"3:0", 0, 0, false},
+		{"-3:2", 0, 0, false},
+		{"3x2", 0, 0, false},
+		{"3:2:1", 0, 0, false},
+		{"width", 0, 0, false},
+	}
+	for _, tt := range tests {
+		w, h, ok := parseRatio(tt.in)
+		if w != tt.w || h != tt.h || ok != tt.ok {
+			t.Errorf("parseRatio(%q) = %d, %d, %v, want %d, %d, %v", tt.in, w, h, ok, tt.w, tt.h, tt.ok)
+		}
+	}
+}
+
+func TestFitWidth(t *testing.T) {
+	tests := []struct {
+		name       string
+		size, w, h int
+		fit        string
+		want       int
+	}{
+		{"width landscape", 2048, 6000, 4000, "width", 2048},
+		{"width portrait", 2048, 4000, 6000, "width", 2048},
+		{"long edge landscape", 2048, 6000, 4000, "long-edge", 2048},
+		{"long edge portrait", 2048, 4000, 6000, "long-edge", 1365},
+		{"long edge square", 750, 3000, 3000, "long-edge", 750},
+		{"box wider photo", 1500, 6000, 2000, "3:2", 1500},
+		{"box taller photo", 1500, 4000, 6000, "3:2", 667},
+		{"box same ratio", 1500, 6000, 4000, "3:2", 1500},
+		{"never upscaled", 2048, 1200, 800, "width", 1200},
+		{"never upscaled long edge", 2048, 800, 1200, "long-edge", 800},
+		{"never upscaled box", 2048, 800, 1200, "3:2", 800},
+		{"at least a pixel", 100, 1, 100000, "long-edge", 1},
+		{"unknown dimensions", 750, 0, 0, "long-edge", 750},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fitWidth(tt.size, tt.w, tt.h, tt.fit); got != tt.want {
+				t.Errorf("fitWidth(%d, %d, %d, %q) = %d, want %d", tt.size, tt.w, tt.h, tt.fit, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFitSizes(t *testing.T) {
+	tests := []struct {
+		name          string
+		sizes         []int
+		w, h          int
+		fit           string
+		wantWidths    []int
+		wantRequested []int
+	}{
+		{"large photo", []int{2048, 750}, 6000, 4000, "width", []int{2048, 750}, []int{2048, 750}},
+		{"capped and deduplicated", []int{3840, 2048, 750}, 1600, 1067, "width", []int{1600, 750}, []int{3840, 750}},
+		{"all capped", []int{2048, 1280}, 620, 400, "width", []int{620}, []int{2048}},
+		{"portrait long edge", []int{2048, 750}, 4000, 6000, "long-edge", []int{1365, 500}, []int{2048, 750}},
+		{"no sizes", nil, 6000, 4000, "width", nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			widths, requested := fitSizes(tt.sizes, tt.w, tt.h, tt.fit)
+			if !reflect.DeepEqual(widths, tt.wantWidths) || !reflect.DeepEqual(requested, tt.wantRequested) {
+				t.Errorf("fitSizes = %v, %v, want %v, %v", widths, requested, tt.wantWidths, tt.wantRequested)
+			}
+		})
+	}
+}
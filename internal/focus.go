@@ -0,0 +1,161 @@
+package internal
+
+import (
+	"fmt"
+	"image"
+	"math"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/disintegration/imaging"
+	"github.com/fatih/color"
+	"github.com/spf13/viper"
+)
+
+// crop is an aspect ratio the cover is pre-cropped to
+type crop struct {
+	name string
+	w, h int
+}
+
+// in the order browsers try them, 16:9 matches any screen left
+var coverCrops = []crop{{"4x5", 4, 5}, {"2x1", 2, 1}, {"16x9", 16, 9}}
+
+// GetCrops returns pre-cropped aspect ratios of given photo type
+func GetCrops(photoType string) []string {
+	if photoType != "cover" {
+		return nil
+	}
+	var names []string
+	for _, c := range coverCrops {
+		names = append(names, c.name)
+	}
+	return names
+}
+
+// getFocus returns the focal point of a photo, from 0 0 (top left) to 1 1
+// (bottom right). A sidecar `<photo>.toml` takes precedence over the
+// `[focus]` section of `moul.toml`, photos default to their center.
+func getFocus(path string) []float64 {
+	if config := readSidecar(path); config != nil && config.IsSet("focus") {
+		return parseFocus(path+".toml", config.Get("focus"))
+	}
+
+	// keys are relative to `photos`, e.g. "cover/DSC_0001.jpg"
+	key := filepath.ToSlash(path)
+	if i := strings.LastIndex(key, "/photos/"); i >= 0 {
+		key = key[i+len("/photos/"):]
+	}
+	// viper lowercases keys
+	if value, ok := GetConfig().GetStringMap("focus")[strings.ToLower(key)]; ok {
+		return parseFocus("focus."+key, value)
+	}
+
+	return []float64{0.5, 0.5}
+}
+
+var focusWarned sync.Map
+
+func parseFocus(key string, value interface{}) []float64 {
+	values, ok := value.([]interface{})
+	if ok && len(values) == 2 {
+		var focus []float64
+		for _, v := range values {
+			var f float64
+			switch n := v.(type) {
+			case float64:
+				f = n
+			case int64:
+				f = float64(n)
+			default:
+				f = -1
+			}
+			if f < 0 || f > 1 {
+				break
+			}
+			focus = append(focus, f)
+		}
+		if len(focus) == 2 {
+			return focus
+		}
+	}
+	if _, warned := focusWarned.LoadOrStore(key, true); !warned {
+		color.Yellow("Invalid `%s`, expected [x, y] between 0 and 1, using the center", key)
+	}
+	return []float64{0.5, 0.5}
+}
+
+func objectPosition(focus []float64) string {
+	return fmt.Sprintf("%g%% %g%%", math.Round(focus[0]*1000)/10, math.Round(focus[1]*1000)/10)
+}
+
+// GetObjectPosition returns the CSS `object-position` of a photo
+func GetObjectPosition(path string) string {
+	return objectPosition(getFocus(path))
+}
+
+// GetCachedObjectPosition returns the CSS `object-position` of an exported
+// photo, centered when it was cached without focal point
+func GetCachedObjectPosition(config *viper.Viper, fn string) string {
+	values, ok := config.Get(fn + ".focus").([]interface{})
+	if !ok || len(values) != 2 {
+		return "50% 50%"
+	}
+	focus := make([]float64, 2)
+	for i, v := range values {
+		f, ok := v.(float64)
+		if !ok {
+			return "50% 50%"
+		}
+		focus[i] = f
+	}
+	return objectPosition(focus)
+}
+
+// GetCropMedia returns the media query that selects a cover crop in given
+// `style.cover` layout, the last crop matches everything else
+func GetCropMedia(layout, name string) string {
+	switch name {
+	case "4x5":
+		// left and right covers fill half of a landscape screen
+		if layout == "left" || layout == "right" {
+			return "(min-width: 601px), (max-aspect-ratio: 1/1)"
+		}
+		return "(max-aspect-ratio: 1/1)"
+	case "2x1":
+		return "(min-aspect-ratio: 8/5)"
+	}
+	return ""
+}
+
+// cropAround cuts the largest area of given ratio out of an image, keeping
+// the focal point as close to its center as the edges allow
+func cropAround(img image.Image, c crop, focus []float64) image.Image {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	cw, ch := cropSize(w, h, c)
+	x := clampInt(int(focus[0]*float64(w))-cw/2, 0, w-cw)
+	y := clampInt(int(focus[1]*float64(h))-ch/2, 0, h-ch)
+
+	return imaging.Crop(img, image.Rect(x, y, x+cw, y+ch).Add(b.Min))
+}
+
+// cropSize returns the dimensions of the largest area of given ratio
+func cropSize(w, h int, c crop) (int, int) {
+	cw, ch := w, w*c.h/c.w
+	if ch > h {
+		cw, ch = h*c.w/c.h, h
+	}
+	return cw, ch
+}
+
+func clampInt(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
@@ -0,0 +1,164 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"image"
+	"io"
+	"os"
+
+	"github.com/disintegration/imaging"
+	// imaging registers every other source format
+	_ "golang.org/x/image/webp"
+)
+
+// source formats recognised by their leading bytes, `?` matches any byte
+var sourceFormats = []struct {
+	name, magic string
+}{
+	{"jpeg", "\xff\xd8\xff"},
+	{"png", "\x89PNG\r\n\x1a\n"},
+	{"gif", "GIF8"},
+	{"bmp", "BM"},
+	{"tiff", "II*\x00"},
+	{"tiff", "MM\x00*"},
+	{"webp", "RIFF????WEBP"},
+}
+
+// sniffFormat returns the format of a photo by content, empty when the file
+// is not a supported image whatever its extension
+func sniffFormat(path string) string {
+	f, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	header := make([]byte, 18)
+	n, _ := io.ReadFull(f, header)
+	for _, format := range sourceFormats {
+		if !matchMagic(header[:n], format.magic) {
+			continue
+		}
+		// two bytes also start text files, the DIB header size is checked
+		if format.name == "bmp" && !bmpHeader(header[:n]) {
+			continue
+		}
+		return format.name
+	}
+	return ""
+}
+
+// bmpHeader reports whether the DIB header following the file header has
+// the size of a known version, from OS/2 1.x to BITMAPV5HEADER
+func bmpHeader(header []byte) bool {
+	if len(header) < 18 {
+		return false
+	}
+	switch binary.LittleEndian.Uint32(header[14:]) {
+	case 12, 16, 40, 52, 56, 64, 108, 124:
+		return true
+	}
+	return false
+}
+
+func matchMagic(b []byte, magic string) bool {
+	if len(b) < len(magic) {
+		return false
+	}
+	for i := range magic {
+		if magic[i] != '?' && magic[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// insertPNGChunk adds a chunk right after IHDR, which is always the first
+func insertPNGChunk(png []byte, typ string, data []byte) ([]byte, error) {
+	const ihdr = 8 + 8 + 13 + 4
+	if len(png) < ihdr {
+		return nil, errors.New("invalid png")
+	}
+	chunk := append([]byte(typ), data...)
+
+	var out bytes.Buffer
+	out.Write(png[:ihdr])
+	binary.Write(&out, binary.BigEndian, uint32(len(data)))
+	out.Write(chunk)
+	binary.Write(&out, binary.BigEndian, crc32.ChecksumIEEE(chunk))
+	out.Write(png[ihdr:])
+	return out.Bytes(), nil
+}
+
+// pngChunk returns the data of the first chunk of given type before IDAT
+func pngChunk(data []byte, typ string) []byte {
+	data = bytes.TrimPrefix(data, []byte("\x89PNG\r\n\x1a\n"))
+	for i := 0; i+8 <= len(data); {
+		size := int(binary.BigEndian.Uint32(data[i:]))
+		t := string(data[i+4 : i+8])
+		if size < 0 || i+8+size > len(data) || t == "IDAT" {
+			break
+		}
+		if t == typ {
+			return data[i+8 : i+8+size]
+		}
+		i += 12 + size
+	}
+	return nil
+}
+
+// webpChunk returns the data of the first RIFF chunk with given FourCC
+func webpChunk(data []byte, fourcc string) []byte {
+	if !matchMagic(data, "RIFF????WEBP") {
+		return nil
+	}
+	for i := 12; i+8 <= len(data); {
+		size := int(binary.LittleEndian.Uint32(data[i+4:]))
+		if size < 0 || i+8+size > len(data) {
+			break
+		}
+		if string(data[i:i+4]) == fourcc {
+			return data[i+8 : i+8+size]
+		}
+		// chunks are padded to an even size
+		i += 8 + size + size&1
+	}
+	return nil
+}
+
+// orient applies EXIF orientation, imaging only reads it from JPEG
+func orient(img image.Image, orientation int) image.Image {
+	switch orientation {
+	case 2:
+		return imaging.FlipH(img)
+	case 3:
+		return imaging.Rotate180(img)
+	case 4:
+		return imaging.FlipV(img)
+	case 5:
+		return imaging.Transpose(img)
+	case 6:
+		return imaging.Rotate270(img)
+	case 7:
+		return imaging.Transverse(img)
+	case 8:
+		return imaging.Rotate90(img)
+	}
+	return img
+}
+
+// CheckDecode returns why a photo cannot be decoded, nil when it can
+func CheckDecode(path string) error {
+	if _, err := openImage(path, "srgb"); err != nil {
+		return decodeError(path, err)
+	}
+	return nil
+}
+
+func decodeError(path string, err error) error {
+	return fmt.Errorf("cannot decode %s: %v", sniffFormat(path), err)
+}
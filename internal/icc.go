@@ -0,0 +1,574 @@
+package internal
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"image"
+	"io"
+	"io/ioutil"
+	"math"
+	"sort"
+	"unicode/utf16"
+
+	"github.com/disintegration/imaging"
+)
+
+// Only matrix/TRC RGB profiles are converted, which covers sRGB, Adobe RGB,
+// Display P3 and ProPhoto. LUT based and non RGB profiles are left alone.
+
+var errNoICC = errors.New("icc: no profile")
+
+// errUnsupportedICC is returned for profiles that cannot be converted
+var errUnsupportedICC = errors.New("icc: unsupported profile")
+
+// ICC PCS illuminant
+var d50 = [3]float64{0.9642, 1, 0.8249}
+
+type iccProfile struct {
+	name   string
+	matrix [3][3]float64 // linear RGB to PCS XYZ, columns are the colorants
+	trc    [3][256]float64
+}
+
+// target color spaces of `image.profile`, both use the sRGB tone curve
+var (
+	srgbProfile = newProfile("sRGB", [3][2]float64{{0.64, 0.33}, {0.30, 0.60}, {0.15, 0.06}})
+	p3Profile   = newProfile("Display P3", [3][2]float64{{0.680, 0.320}, {0.265, 0.690}, {0.150, 0.060}})
+)
+
+func newProfile(name string, primaries [3][2]float64) *iccProfile {
+	p := &iccProfile{name: name, matrix: primariesToXYZ(primaries)}
+	for c := range p.trc {
+		for i := range p.trc[c] {
+			p.trc[c][i] = srgbToLinear(uint8(i))
+		}
+	}
+	return p
+}
+
+// primariesToXYZ returns the D50 adapted matrix of a D65 RGB color space
+func primariesToXYZ(primaries [3][2]float64) [3][3]float64 {
+	const wx, wy = 0.3127, 0.3290
+	var m [3][3]float64
+	for c, p := range primaries {
+		m[0][c] = p[0] / p[1]
+		m[1][c] = 1
+		m[2][c] = (1 - p[0] - p[1]) / p[1]
+	}
+	s := mulVec(invert(m), [3]float64{wx / wy, 1, (1 - wx - wy) / wy})
+	for r := range m {
+		for c := range m[r] {
+			m[r][c] *= s[c]
+		}
+	}
+	return mulMat(bradford([3]float64{wx / wy, 1, (1 - wx - wy) / wy}), m)
+}
+
+// bradford chromatic adaptation from given white to D50
+func bradford(white [3]float64) [3][3]float64 {
+	b := [3][3]float64{
+		{0.8951, 0.2664, -0.1614},
+		{-0.7502, 1.7135, 0.0367},
+		{0.0389, -0.0685, 1.0296},
+	}
+	src, dst := mulVec(b, white), mulVec(b, d50)
+	scale := [3][3]float64{{dst[0] / src[0]}, {0, dst[1] / src[1]}, {0, 0, dst[2] / src[2]}}
+	return mulMat(invert(b), mulMat(scale, b))
+}
+
+func mulMat(a, b [3][3]float64) [3][3]float64 {
+	var m [3][3]float64
+	for r := 0; r < 3; r++ {
+		for c := 0; c < 3; c++ {
+			m[r][c] = a[r][0]*b[0][c] + a[r][1]*b[1][c] + a[r][2]*b[2][c]
+		}
+	}
+	return m
+}
+
+func mulVec(a [3][3]float64, v [3]float64) [3]float64 {
+	return [3]float64{
+		a[0][0]*v[0] + a[0][1]*v[1] + a[0][2]*v[2],
+		a[1][0]*v[0] + a[1][1]*v[1] + a[1][2]*v[2],
+		a[2][0]*v[0] + a[2][1]*v[1] + a[2][2]*v[2],
+	}
+}
+
+func invert(m [3][3]float64) [3][3]float64 {
+	det := m[0][0]*(m[1][1]*m[2][2]-m[1][2]*m[2][1]) -
+		m[0][1]*(m[1][0]*m[2][2]-m[1][2]*m[2][0]) +
+		m[0][2]*(m[1][0]*m[2][1]-m[1][1]*m[2][0])
+	return [3][3]float64{
+		{(m[1][1]*m[2][2] - m[1][2]*m[2][1]) / det, (m[0][2]*m[2][1] - m[0][1]*m[2][2]) / det, (m[0][1]*m[1][2] - m[0][2]*m[1][1]) / det},
+		{(m[1][2]*m[2][0] - m[1][0]*m[2][2]) / det, (m[0][0]*m[2][2] - m[0][2]*m[2][0]) / det, (m[0][2]*m[1][0] - m[0][0]*m[1][2]) / det},
+		{(m[1][0]*m[2][1] - m[1][1]*m[2][0]) / det, (m[0][1]*m[2][0] - m[0][0]*m[2][1]) / det, (m[0][0]*m[1][1] - m[0][1]*m[1][0]) / det},
+	}
+}
+
+// tagICC holds the profile in TIFF files
+const tagICC = 0x8773
+
+// readICC returns the raw ICC profile embedded in a photo
+func readICC(path string) ([]byte, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch sniffFormat(path) {
+	case "jpeg":
+		return readJPEGICC(data)
+	case "png":
+		chunk := pngChunk(data, "iCCP")
+		name := bytes.IndexByte(chunk, 0)
+		if name < 0 || name+2 > len(chunk) {
+			return nil, errNoICC
+		}
+		r, err := zlib.NewReader(bytes.NewReader(chunk[name+2:]))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return ioutil.ReadAll(r)
+	case "tiff":
+		if e, err := parseTIFF(data); err == nil {
+			if tag, ok := e.ifd0[tagICC]; ok {
+				return tag.value, nil
+			}
+		}
+	case "webp":
+		if chunk := webpChunk(data, "ICCP"); chunk != nil {
+			return chunk, nil
+		}
+	}
+	return nil, errNoICC
+}
+
+// profiles larger than a segment are split over several APP2 chunks
+func readJPEGICC(data []byte) ([]byte, error) {
+	if len(data) < 2 || data[0] != 0xff || data[1] != 0xd8 {
+		return nil, errNoICC
+	}
+	chunks := map[int][]byte{}
+	for i := 2; i+4 <= len(data); {
+		marker := binary.BigEndian.Uint16(data[i:])
+		size := int(binary.BigEndian.Uint16(data[i+2:]))
+		if marker>>8 != 0xff || marker == 0xffda || size < 2 || i+2+size > len(data) {
+			break
+		}
+		segment := data[i+4 : i+2+size]
+		if marker == 0xffe2 && bytes.HasPrefix(segment, []byte("ICC_PROFILE\x00")) && len(segment) > 14 {
+			chunks[int(segment[12])] = segment[14:]
+		}
+		i += 2 + size
+	}
+	if len(chunks) == 0 {
+		return nil, errNoICC
+	}
+
+	var seqs []int
+	for seq := range chunks {
+		seqs = append(seqs, seq)
+	}
+	sort.Ints(seqs)
+	var profile []byte
+	for _, seq := range seqs {
+		profile = append(profile, chunks[seq]...)
+	}
+	return profile, nil
+}
+
+// parseICC reads name, colorants and tone curves of an RGB display profile
+func parseICC(data []byte) (*iccProfile, error) {
+	if len(data) < 132 || string(data[36:40]) != "acsp" {
+		return nil, errUnsupportedICC
+	}
+	tags := map[string][]byte{}
+	count := int(binary.BigEndian.Uint32(data[128:]))
+	for i := 0; i < count && 132+i*12+12 <= len(data); i++ {
+		entry := data[132+i*12:]
+		offset, size := int(binary.BigEndian.Uint32(entry[4:])), int(binary.BigEndian.Uint32(entry[8:]))
+		if offset+size <= len(data) && size >= 8 {
+			tags[string(entry[:4])] = data[offset : offset+size]
+		}
+	}
+
+	p := &iccProfile{name: iccName(tags["desc"])}
+	if string(data[16:20]) != "RGB " || string(data[20:24]) != "XYZ " {
+		return p, errUnsupportedICC
+	}
+	for c, sig := range []string{"rXYZ", "gXYZ", "bXYZ"} {
+		tag := tags[sig]
+		if len(tag) < 20 || string(tag[:4]) != "XYZ " {
+			return p, errUnsupportedICC
+		}
+		for r := 0; r < 3; r++ {
+			p.matrix[r][c] = s15Fixed16(tag[8+r*4:])
+		}
+	}
+	for c, sig := range []string{"rTRC", "gTRC", "bTRC"} {
+		curve, err := parseCurve(tags[sig])
+		if err != nil {
+			return p, err
+		}
+		for i := range p.trc[c] {
+			p.trc[c][i] = curve(float64(i) / 255)
+		}
+	}
+	return p, nil
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func appendUint16(b []byte, v uint16) []byte {
+	return append(b, byte(v>>8), byte(v))
+}
+
+func s15Fixed16(b []byte) float64 {
+	return float64(int32(binary.BigEndian.Uint32(b))) / 65536
+}
+
+// iccName decodes v2 `desc` or v4 `mluc` descriptions
+func iccName(tag []byte) string {
+	switch {
+	case len(tag) >= 12 && string(tag[:4]) == "desc":
+		n := int(binary.BigEndian.Uint32(tag[8:]))
+		if 12+n <= len(tag) {
+			return string(bytes.TrimRight(tag[12:12+n], "\x00"))
+		}
+	case len(tag) >= 28 && string(tag[:4]) == "mluc":
+		n, offset := int(binary.BigEndian.Uint32(tag[20:])), int(binary.BigEndian.Uint32(tag[24:]))
+		if offset+n <= len(tag) {
+			var u []uint16
+			for i := offset; i+1 < offset+n; i += 2 {
+				u = append(u, binary.BigEndian.Uint16(tag[i:]))
+			}
+			return string(utf16.Decode(u))
+		}
+	}
+	return ""
+}
+
+// parseCurve returns a `curv` or `para` tone curve as a function
+func parseCurve(tag []byte) (func(float64) float64, error) {
+	if len(tag) < 12 {
+		return nil, errUnsupportedICC
+	}
+	switch string(tag[:4]) {
+	case "curv":
+		n := int(binary.BigEndian.Uint32(tag[8:]))
+		if len(tag) < 12+n*2 {
+			return nil, errUnsupportedICC
+		}
+		switch n {
+		case 0:
+			return func(x float64) float64 { return x }, nil
+		case 1:
+			gamma := float64(binary.BigEndian.Uint16(tag[12:])) / 256
+			return func(x float64) float64 { return math.Pow(x, gamma) }, nil
+		}
+		table := make([]float64, n)
+		for i := range table {
+			table[i] = float64(binary.BigEndian.Uint16(tag[12+i*2:])) / 65535
+		}
+		return func(x float64) float64 {
+			pos := x * float64(n-1)
+			i := int(pos)
+			if i >= n-1 {
+				return table[n-1]
+			}
+			return table[i] + (table[i+1]-table[i])*(pos-float64(i))
+		}, nil
+	case "para":
+		params := map[uint16]int{0: 1, 1: 3, 2: 4, 3: 5, 4: 7}
+		fn := binary.BigEndian.Uint16(tag[8:])
+		n, ok := params[fn]
+		if !ok || len(tag) < 12+n*4 {
+			return nil, errUnsupportedICC
+		}
+		var v [7]float64
+		for i := 0; i < n; i++ {
+			v[i] = s15Fixed16(tag[12+i*4:])
+		}
+		g, a, b, c, d, e, f := v[0], v[1], v[2], v[3], v[4], v[5], v[6]
+		return func(x float64) float64 {
+			switch fn {
+			case 0:
+				return math.Pow(x, g)
+			case 1:
+				if x >= -b/a {
+					return math.Pow(a*x+b, g)
+				}
+				return 0
+			case 2:
+				if x >= -b/a {
+					return math.Pow(a*x+b, g) + c
+				}
+				return c
+			case 3:
+				if x >= d {
+					return math.Pow(a*x+b, g)
+				}
+				return c * x
+			}
+			if x >= d {
+				return math.Pow(a*x+b, g) + e
+			}
+			return c*x + f
+		}, nil
+	}
+	return nil, errUnsupportedICC
+}
+
+// same reports whether two profiles describe the same color space
+func (p *iccProfile) same(o *iccProfile) bool {
+	for r := range p.matrix {
+		for c := range p.matrix[r] {
+			if math.Abs(p.matrix[r][c]-o.matrix[r][c]) > 0.003 {
+				return false
+			}
+		}
+	}
+	for c := range p.trc {
+		for i := range p.trc[c] {
+			if math.Abs(p.trc[c][i]-o.trc[c][i]) > 0.002 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// targetProfile of `image.profile`
+func targetProfile(name string) *iccProfile {
+	if name == "display-p3" {
+		return p3Profile
+	}
+	return srgbProfile
+}
+
+// targetICC returns the profile embedded in variants, sRGB is implied by
+// browsers and left out
+func targetICC(name string) []byte {
+	if name == "display-p3" {
+		return encodeICC(p3Profile)
+	}
+	return nil
+}
+
+// convertProfile converts pixels from src to dst with relative
+// colorimetric intent, out of gamut colors are clipped
+func convertProfile(img image.Image, src, dst *iccProfile) image.Image {
+	if src == nil || src.same(dst) {
+		return img
+	}
+	m := mulMat(invert(dst.matrix), src.matrix)
+
+	// both targets share the sRGB tone curve
+	var encode [4096]uint8
+	for i := range encode {
+		encode[i] = uint8(linearToSrgb(float64(i) / 4095))
+	}
+
+	out := imaging.Clone(img)
+	for i := 0; i < len(out.Pix); i += 4 {
+		rgb := mulVec(m, [3]float64{
+			src.trc[0][out.Pix[i]],
+			src.trc[1][out.Pix[i+1]],
+			src.trc[2][out.Pix[i+2]],
+		})
+		for c, v := range rgb {
+			if !(v > 0) {
+				v = 0
+			}
+			out.Pix[i+c] = encode[int(math.Min(1, v)*4095+0.5)]
+		}
+	}
+	return out
+}
+
+// openImage decodes a photo with EXIF orientation applied, converted to
+// given target profile. Untagged photos and unsupported profiles are
+// assumed to be sRGB, as browsers do.
+func openImage(path, target string) (image.Image, error) {
+	if sniffFormat(path) == "" {
+		return nil, errors.New("not a supported image")
+	}
+	img, err := imaging.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	img = orient(img, getOrientation(path))
+	return convertProfile(img, sourceProfile(path), targetProfile(target)), nil
+}
+
+// sourceProfile of a photo, sRGB unless it embeds a supported profile
+func sourceProfile(path string) *iccProfile {
+	data, err := readICC(path)
+	if err != nil {
+		return srgbProfile
+	}
+	src, err := parseICC(data)
+	if err != nil {
+		return srgbProfile
+	}
+	return src
+}
+
+// ProfileIssue describes how the ICC profile of a photo is handled, empty
+// for photos in sRGB or the target profile and untagged photos exported to
+// sRGB
+func ProfileIssue(path, target string) string {
+	dst := targetProfile(target)
+	data, err := readICC(path)
+	if err != nil {
+		if dst == srgbProfile {
+			return ""
+		}
+		return "no ICC profile, assumed sRGB and converted to " + dst.name
+	}
+	p, err := parseICC(data)
+	name := "unknown profile"
+	if p != nil && p.name != "" {
+		name = p.name
+	}
+	if err != nil {
+		if dst == srgbProfile {
+			return name + " is not supported, assumed sRGB"
+		}
+		return name + " is not supported, assumed sRGB and converted to " + dst.name
+	}
+	if p.same(srgbProfile) || p.same(dst) {
+		return ""
+	}
+	return name + " is converted to " + dst.name
+}
+
+// encodeICC builds a v4 display profile of a target color space
+func encodeICC(p *iccProfile) []byte {
+	xyz := func(v [3]float64) []byte {
+		b := []byte("XYZ \x00\x00\x00\x00")
+		for _, f := range v {
+			b = appendUint32(b, uint32(int32(math.Round(f*65536))))
+		}
+		return b
+	}
+	mluc := func(s string) []byte {
+		u := utf16.Encode([]rune(s))
+		b := []byte("mluc\x00\x00\x00\x00")
+		b = appendUint32(b, 1)
+		b = appendUint32(b, 12)
+		b = append(b, "enUS"...)
+		b = appendUint32(b, uint32(len(u)*2))
+		b = appendUint32(b, 28)
+		for _, c := range u {
+			b = appendUint16(b, c)
+		}
+		return b
+	}
+	// sRGB tone curve as parametric function type 3
+	para := []byte("para\x00\x00\x00\x00\x00\x03\x00\x00")
+	for _, v := range []float64{2.4, 1 / 1.055, 0.055 / 1.055, 1 / 12.92, 0.04045} {
+		para = appendUint32(para, uint32(int32(math.Round(v*65536))))
+	}
+	sf32 := func(m [3][3]float64) []byte {
+		b := []byte("sf32\x00\x00\x00\x00")
+		for r := range m {
+			for c := range m[r] {
+				b = appendUint32(b, uint32(int32(math.Round(m[r][c]*65536))))
+			}
+		}
+		return b
+	}
+	column := func(c int) [3]float64 {
+		return [3]float64{p.matrix[0][c], p.matrix[1][c], p.matrix[2][c]}
+	}
+
+	tags := []struct {
+		sig  string
+		data []byte
+	}{
+		{"desc", mluc(p.name)},
+		{"cprt", mluc("No copyright, use freely")},
+		{"wtpt", xyz(d50)},
+		{"rXYZ", xyz(column(0))},
+		{"gXYZ", xyz(column(1))},
+		{"bXYZ", xyz(column(2))},
+		{"rTRC", para},
+		{"gTRC", para},
+		{"bTRC", para},
+		{"chad", sf32(bradford([3]float64{0.3127 / 0.3290, 1, (1 - 0.3127 - 0.3290) / 0.3290}))},
+	}
+
+	var body bytes.Buffer
+	table := appendUint32(nil, uint32(len(tags)))
+	offset := 128 + 4 + 12*len(tags)
+	for _, tag := range tags {
+		table = append(table, tag.sig...)
+		table = appendUint32(table, uint32(offset+body.Len()))
+		table = appendUint32(table, uint32(len(tag.data)))
+		body.Write(tag.data)
+		for body.Len()%4 != 0 {
+			body.WriteByte(0)
+		}
+	}
+
+	header := make([]byte, 128)
+	binary.BigEndian.PutUint32(header[0:], uint32(128+len(table)+body.Len()))
+	binary.BigEndian.PutUint32(header[8:], 0x04300000)
+	copy(header[12:], "mntrRGB XYZ ")
+	copy(header[36:], "acsp")
+	copy(header[68:], xyz(d50)[8:])
+
+	return append(append(header, table...), body.Bytes()...)
+}
+
+// writeICC inserts APP2 ICC segments right after SOI of a JPEG file
+func writeICC(path string, profile []byte) error {
+	jpg, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if len(jpg) < 2 {
+		return nil
+	}
+
+	const chunk = 0xffff - 2 - 14
+	count := (len(profile) + chunk - 1) / chunk
+	var out bytes.Buffer
+	out.Write(jpg[:2])
+	for i := 0; i < count; i++ {
+		end := (i + 1) * chunk
+		if end > len(profile) {
+			end = len(profile)
+		}
+		data := profile[i*chunk : end]
+		out.Write([]byte{0xff, 0xe2})
+		binary.Write(&out, binary.BigEndian, uint16(len(data)+2+14))
+		out.WriteString("ICC_PROFILE\x00")
+		out.Write([]byte{byte(i + 1), byte(count)})
+		out.Write(data)
+	}
+	out.Write(jpg[2:])
+
+	return replaceFile(path, out.Bytes())
+}
+
+// writePNGICC writes a PNG with an iCCP chunk that encoders carry over
+func writePNGICC(w io.Writer, png []byte, profile []byte) error {
+	var compressed bytes.Buffer
+	zw := zlib.NewWriter(&compressed)
+	zw.Write(profile)
+	zw.Close()
+
+	out, err := insertPNGChunk(png, "iCCP", append([]byte("ICC Profile\x00\x00"), compressed.Bytes()...))
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(out)
+	return err
+}
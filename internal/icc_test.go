@@ -0,0 +1,178 @@
+package internal
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/disintegration/imaging"
+)
+
+func curveTag(typ string, head []byte, values ...uint16) []byte {
+	tag := append([]byte(typ+"\x00\x00\x00\x00"), head...)
+	for _, v := range values {
+		tag = appendUint16(tag, v)
+	}
+	return tag
+}
+
+func paraTag(fn uint16, params ...float64) []byte {
+	tag := appendUint16([]byte("para\x00\x00\x00\x00"), fn)
+	tag = append(tag, 0, 0)
+	for _, v := range params {
+		tag = appendUint32(tag, uint32(int32(math.Round(v*65536))))
+	}
+	return tag
+}
+
+func TestParseCurve(t *testing.T) {
+	tests := []struct {
+		name string
+		tag  []byte
+		in   float64
+		want float64
+	}{
+		{"curv identity", curveTag("curv", appendUint32(nil, 0)), 0.5, 0.5},
+		{"curv gamma", curveTag("curv", appendUint32(nil, 1), 2<<8), 0.5, 0.25},
+		{"curv table", curveTag("curv", appendUint32(nil, 3), 0, 0x4000, 0xffff), 0.25, 0.125},
+		{"curv table end", curveTag("curv", appendUint32(nil, 3), 0, 0x4000, 0xffff), 1, 1},
+		{"para gamma", paraTag(0, 2.2), 0.5, math.Pow(0.5, 2.2)},
+		{"para srgb", paraTag(3, 2.4, 1/1.055, 0.055/1.055, 1/12.92, 0.04045), 0.02, 0.02 / 12.92},
+		{"para srgb high", paraTag(3, 2.4, 1/1.055, 0.055/1.055, 1/12.92, 0.04045), 0.5, math.Pow((0.5+0.055)/1.055, 2.4)},
+		{"para offset", paraTag(2, 1, 1, 0, 0.25), 0.5, 0.75},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			curve, err := parseCurve(tt.tag)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := curve(tt.in); math.Abs(got-tt.want) > 1e-3 {
+				t.Errorf("curve(%g) = %g, want %g", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCurveInvalid(t *testing.T) {
+	for name, tag := range map[string][]byte{
+		"short":           []byte("curv"),
+		"truncated table": curveTag("curv", appendUint32(nil, 4), 0, 1),
+		"unknown para":    paraTag(9, 1),
+		"truncated para":  paraTag(4, 1, 1),
+		"unknown type":    curveTag("mAB ", appendUint32(nil, 0)),
+	} {
+		if _, err := parseCurve(tag); err != errUnsupportedICC {
+			t.Errorf("%s: err = %v, want %v", name, err, errUnsupportedICC)
+		}
+	}
+}
+
+func TestParseICCRoundTrip(t *testing.T) {
+	for _, want := range []*iccProfile{srgbProfile, p3Profile} {
+		t.Run(want.name, func(t *testing.T) {
+			got, err := parseICC(encodeICC(want))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.name != want.name {
+				t.Errorf("name = %q, want %q", got.name, want.name)
+			}
+			if !got.same(want) {
+				t.Errorf("matrix = %v, want %v", got.matrix, want.matrix)
+			}
+		})
+	}
+	if srgbProfile.same(p3Profile) {
+		t.Error("sRGB and Display P3 are the same")
+	}
+}
+
+func TestParseICCUnsupported(t *testing.T) {
+	gray := encodeICC(srgbProfile)
+	copy(gray[16:], "GRAY")
+	noColorants := encodeICC(srgbProfile)
+	copy(noColorants[132+12*3:], "xxxx") // rXYZ is the fourth tag
+
+	tests := []struct {
+		name     string
+		data     []byte
+		wantName string
+	}{
+		{"short", []byte("acsp"), ""},
+		{"no signature", make([]byte, 200), ""},
+		{"gray", gray, "sRGB"},
+		{"no colorants", noColorants, "sRGB"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := parseICC(tt.data)
+			if err != errUnsupportedICC {
+				t.Errorf("err = %v, want %v", err, errUnsupportedICC)
+			}
+			// the name is still reported by `moul check`
+			if p != nil && p.name != tt.wantName {
+				t.Errorf("name = %q, want %q", p.name, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestConvertProfile(t *testing.T) {
+	tests := []struct {
+		name     string
+		src, dst *iccProfile
+		in, want color.NRGBA
+	}{
+		{"same profile", srgbProfile, srgbProfile, color.NRGBA{255, 0, 0, 255}, color.NRGBA{255, 0, 0, 255}},
+		{"white", srgbProfile, p3Profile, color.NRGBA{255, 255, 255, 255}, color.NRGBA{255, 255, 255, 255}},
+		{"red to P3", srgbProfile, p3Profile, color.NRGBA{255, 0, 0, 255}, color.NRGBA{234, 51, 35, 255}},
+		{"P3 red clipped", p3Profile, srgbProfile, color.NRGBA{255, 0, 0, 128}, color.NRGBA{255, 0, 0, 128}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			img := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+			img.SetNRGBA(0, 0, tt.in)
+			got := imaging.Clone(convertProfile(img, tt.src, tt.dst)).NRGBAAt(0, 0)
+			for i, c := range [][2]uint8{{got.R, tt.want.R}, {got.G, tt.want.G}, {got.B, tt.want.B}, {got.A, tt.want.A}} {
+				if d := int(c[0]) - int(c[1]); d < -1 || d > 1 {
+					t.Errorf("channel %d = %v, want %v", i, got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestWriteICCRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "moul-icc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "variant.jpg")
+	if err := imaging.Save(image.NewNRGBA(image.Rect(0, 0, 8, 8)), path); err != nil {
+		t.Fatal(err)
+	}
+	// larger than a segment, split over several APP2 chunks
+	want := append(encodeICC(p3Profile), bytes.Repeat([]byte{0x5a}, 0x10000)...)
+	if err := writeICC(path, want); err != nil {
+		t.Fatal(err)
+	}
+	got, err := readICC(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("profile of %d bytes, want %d", len(got), len(want))
+	}
+	if err := verifyFile(path); err != nil {
+		t.Errorf("verifyFile: %v", err)
+	}
+}
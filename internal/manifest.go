@@ -0,0 +1,144 @@
+package internal
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/fatih/color"
+	"github.com/spf13/viper"
+)
+
+// The cache is described by `.moul/manifest.toml`, one section per output
+// prefix listing its cached photos, `photos` listing published files and
+// `store` describing stored sources. Its version changes with the layout of
+// `.moul`, older caches are migrated and newer ones refused.
+const manifestVersion = 1
+
+// serializes updates of the manifest shared by all sections
+var manifestMu sync.Mutex
+
+var manifestPath = filepath.Join(".moul", "manifest.toml")
+
+// CheckManifest migrates a cache written by an older version of moul and
+// fails on one written by a newer version
+func CheckManifest() error {
+	manifestMu.Lock()
+	defer manifestMu.Unlock()
+	_, err := loadManifest()
+	return err
+}
+
+// GetManifest returns a section of the manifest, empty when it does not
+// exist yet
+func GetManifest(name string) *viper.Viper {
+	manifestMu.Lock()
+	defer manifestMu.Unlock()
+	config, err := loadManifest()
+	if err != nil {
+		return viper.New()
+	}
+	if section := config.Sub(name); section != nil {
+		return section
+	}
+	return viper.New()
+}
+
+// writeManifest replaces a section of the manifest
+func writeManifest(section *viper.Viper, name string) error {
+	return updateManifest(name, section)
+}
+
+// removeManifest drops a section of the manifest
+func removeManifest(name string) error {
+	return updateManifest(name, nil)
+}
+
+func updateManifest(name string, section *viper.Viper) error {
+	manifestMu.Lock()
+	defer manifestMu.Unlock()
+	config, err := loadManifest()
+	if err != nil {
+		return err
+	}
+
+	// a fresh config, setting a section does not drop keys it no longer has
+	out := viper.New()
+	for k, v := range config.AllSettings() {
+		if k != name {
+			out.Set(k, v)
+		}
+	}
+	if section != nil {
+		out.Set(name, section.AllSettings())
+	}
+	return saveManifest(out)
+}
+
+func loadManifest() (*viper.Viper, error) {
+	if _, err := os.Stat(manifestPath); os.IsNotExist(err) {
+		return migrateManifest()
+	}
+
+	config := viper.New()
+	config.SetConfigFile(manifestPath)
+	if err := config.ReadInConfig(); err != nil || config.GetInt("version") < 1 {
+		// the next export records photos again, stored variants are only
+		// linked again
+		color.Yellow("Rebuilding corrupted cache manifest `%s`", manifestPath)
+		os.Rename(manifestPath, manifestPath+".bak")
+		return viper.New(), nil
+	}
+	if version := config.GetInt("version"); version > manifestVersion {
+		return nil, fmt.Errorf("`.moul` was written by a newer version of moul (cache version %d, supported %d), upgrade moul or remove the cache with `moul clean --all`", version, manifestVersion)
+	}
+	return config, nil
+}
+
+// migrateManifest merges the manifests of caches written before versioning,
+// one file per section, into the manifest
+func migrateManifest() (*viper.Viper, error) {
+	config := viper.New()
+	files, _ := filepath.Glob(filepath.Join(".moul", "*.toml"))
+	if len(files) == 0 {
+		return config, nil
+	}
+
+	for _, path := range files {
+		name := strings.TrimSuffix(filepath.Base(path), ".toml")
+		// left by an interrupted export
+		if strings.HasSuffix(name, ".tmp") {
+			continue
+		}
+		section := viper.New()
+		section.SetConfigFile(path)
+		if err := section.ReadInConfig(); err != nil {
+			color.Yellow("Rebuilding corrupted cache manifest `%s`", path)
+			continue
+		}
+		config.Set(name, section.AllSettings())
+	}
+	if err := saveManifest(config); err != nil {
+		return nil, err
+	}
+	for _, path := range files {
+		os.Remove(path)
+	}
+	return config, nil
+}
+
+// saveManifest replaces the manifest atomically, an interrupted export never
+// leaves a truncated manifest behind
+func saveManifest(config *viper.Viper) error {
+	config.Set("version", manifestVersion)
+	if err := os.MkdirAll(".moul", 0755); err != nil {
+		return err
+	}
+	tmp := filepath.Join(".moul", "manifest.tmp.toml")
+	if err := config.WriteConfigAs(tmp); err != nil {
+		return err
+	}
+	return os.Rename(tmp, manifestPath)
+}
@@ -0,0 +1,155 @@
+package internal
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+// inTempDir runs the test in an empty directory, the cache is relative to
+// the collection
+func inTempDir(t *testing.T) func() {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "moul-manifest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	return func() {
+		os.Chdir(wd)
+		os.RemoveAll(dir)
+	}
+}
+
+func writeFiles(t *testing.T, files map[string]string) {
+	t.Helper()
+	for path, data := range files {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestMigrateBaselineCache(t *testing.T) {
+	defer inTempDir(t)()
+
+	// one file per section, as written before the manifest
+	writeFiles(t, map[string]string{
+		".moul/collection.toml":  "[dsc_0001-jpg]\nsha = \"0a1b\"\nid = \"u1\"\n\n[dsc_0002-jpg]\nsha = \"2c3d\"\nid = \"u2\"\n",
+		".moul/cover.toml":       "[cover-jpg]\nsha = \"4e5f\"\nid = \"u3\"\n",
+		".moul/photos.toml":      "collection = [\".moul/photos/u1/collection/750/dsc_0001-by-jane.jpg\"]\ncover = []\n",
+		".moul/avatar.toml":      "[avatar-jpg\nsha = ",
+		".moul/section.tmp.toml": "[left-by-an-interrupted-export]\n",
+	})
+
+	tests := []struct {
+		section, key string
+		want         interface{}
+	}{
+		{"collection", "dsc_0001-jpg.sha", "0a1b"},
+		{"collection", "dsc_0002-jpg.id", "u2"},
+		{"cover", "cover-jpg.id", "u3"},
+		{"photos", "collection", []interface{}{".moul/photos/u1/collection/750/dsc_0001-by-jane.jpg"}},
+	}
+	for _, tt := range tests {
+		if got := GetManifest(tt.section).Get(tt.key); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s.%s = %#v, want %#v", tt.section, tt.key, got, tt.want)
+		}
+	}
+	// corrupted sections and temporary files are dropped
+	for _, section := range []string{"avatar", "section"} {
+		if keys := GetManifest(section).AllKeys(); len(keys) > 0 {
+			t.Errorf("section %s migrated: %v", section, keys)
+		}
+	}
+
+	files, _ := filepath.Glob(filepath.Join(".moul", "*.toml"))
+	if !reflect.DeepEqual(files, []string{manifestPath}) {
+		t.Errorf("files = %v, want only %s", files, manifestPath)
+	}
+	config := viper.New()
+	config.SetConfigFile(manifestPath)
+	if err := config.ReadInConfig(); err != nil {
+		t.Fatal(err)
+	}
+	if v := config.GetInt("version"); v != manifestVersion {
+		t.Errorf("version = %d, want %d", v, manifestVersion)
+	}
+}
+
+func TestManifestVersions(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+		wantErr  bool
+		wantKeys []string
+		rebuilt  bool // kept aside as `manifest.toml.bak`
+	}{
+		{"current", "version = 1\n[collection.a-jpg]\nid = \"u1\"\n", false, []string{"a-jpg.id"}, false},
+		{"newer", "version = 99\n[collection.a-jpg]\nid = \"u1\"\n", true, nil, false},
+		{"unversioned", "[collection.a-jpg]\nid = \"u1\"\n", false, nil, true},
+		{"corrupted", "version = \n", false, nil, true},
+		{"missing", "", false, nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer inTempDir(t)()
+			if tt.manifest != "" {
+				writeFiles(t, map[string]string{manifestPath: tt.manifest})
+			}
+
+			err := CheckManifest()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CheckManifest = %v, want error %v", err, tt.wantErr)
+			}
+			if keys := GetManifest("collection").AllKeys(); len(keys) != len(tt.wantKeys) || (len(keys) > 0 && keys[0] != tt.wantKeys[0]) {
+				t.Errorf("keys = %v, want %v", keys, tt.wantKeys)
+			}
+			if _, err := os.Stat(manifestPath + ".bak"); tt.rebuilt != (err == nil) {
+				t.Errorf("backup exists %v, want %v", err == nil, tt.rebuilt)
+			}
+		})
+	}
+}
+
+func TestUpdateManifestDropsRemovedKeys(t *testing.T) {
+	defer inTempDir(t)()
+
+	first := viper.New()
+	first.Set("a-jpg.id", "u1")
+	first.Set("b-jpg.id", "u2")
+	if err := writeManifest(first, "collection"); err != nil {
+		t.Fatal(err)
+	}
+	second := viper.New()
+	second.Set("b-jpg.id", "u2")
+	if err := writeManifest(second, "collection"); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeManifest(second, "cover"); err != nil {
+		t.Fatal(err)
+	}
+	if err := removeManifest("cover"); err != nil {
+		t.Fatal(err)
+	}
+
+	if keys := GetManifest("collection").AllKeys(); !reflect.DeepEqual(keys, []string{"b-jpg.id"}) {
+		t.Errorf("collection = %v, want [b-jpg.id]", keys)
+	}
+	if keys := GetManifest("cover").AllKeys(); len(keys) > 0 {
+		t.Errorf("cover = %v, want removed", keys)
+	}
+}
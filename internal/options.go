@@ -0,0 +1,110 @@
+package internal
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os/exec"
+	"sync"
+
+	"github.com/fatih/color"
+)
+
+// JPEGOptions of `[image.jpeg]`
+type JPEGOptions struct {
+	Quality     int
+	Progressive bool
+	Subsampling string
+	Optimize    bool
+	Target      float64 // SSIM searched from `min_quality` up to `quality`, 0 disables
+	MinQuality  int
+}
+
+// Options holds every setting that affects generated variants
+type Options struct {
+	Formats   []string
+	Quality   map[string]int // quality of each modern format
+	JPEG      JPEGOptions
+	Resample  ResampleOptions
+	Keep      []string // EXIF fields published with JPEG variants
+	Profile   string   // color space of variants
+	Alpha     AlphaOptions
+	Watermark WatermarkOptions
+}
+
+// GetOptions reads image processing options from `moul.toml`
+func GetOptions() Options {
+	config := GetConfig()
+
+	return Options{
+		Formats:   GetFormats(),
+		Quality:   getQuality(),
+		JPEG:      getJPEG(),
+		Resample:  getResample(),
+		Keep:      config.GetStringSlice("privacy.keep"),
+		Profile:   config.GetString("image.profile"),
+		Alpha:     getAlpha(),
+		Watermark: getWatermark(),
+	}
+}
+
+var jpegWarned sync.Map
+
+// getJPEG reads `[image.jpeg]`. Options needing `cjpeg` are reset when it is
+// missing so that toggling them does not invalidate identical variants.
+func getJPEG() JPEGOptions {
+	o := configuredJPEG()
+	if !usesCJPEG(o) {
+		return o
+	}
+	if _, err := exec.LookPath("cjpeg"); err != nil {
+		if _, warned := jpegWarned.LoadOrStore("cjpeg", true); !warned {
+			color.Yellow("Skipped `image.jpeg` options: `cjpeg` is not found in PATH")
+		}
+		o.Progressive = false
+		o.Optimize = false
+		o.Subsampling = "4:2:0"
+	}
+	return o
+}
+
+// configuredJPEG reads `[image.jpeg]` as configured, whether `cjpeg` is
+// installed or not
+func configuredJPEG() JPEGOptions {
+	config := GetConfig()
+	o := JPEGOptions{
+		Quality:     config.GetInt("image.jpeg.quality"),
+		Progressive: config.GetBool("image.jpeg.progressive"),
+		Subsampling: config.GetString("image.jpeg.subsampling"),
+		Optimize:    config.GetBool("image.jpeg.optimize"),
+		Target:      config.GetFloat64("image.jpeg.target"),
+		MinQuality:  config.GetInt("image.jpeg.min_quality"),
+	}
+	if o.Subsampling != "4:2:0" && o.Subsampling != "4:4:4" {
+		if _, warned := jpegWarned.LoadOrStore("subsampling", true); !warned {
+			color.Yellow("Unknown JPEG subsampling `%s`, using `4:2:0`", o.Subsampling)
+		}
+		o.Subsampling = "4:2:0"
+	}
+	return o
+}
+
+// Params returns a fingerprint of the options, a different fingerprint
+// invalidates cached variants
+func (o Options) Params() string {
+	hash := sha1.Sum([]byte(fmt.Sprintf("%+v", o)))
+	return hex.EncodeToString(hash[:])
+}
+
+// qualityParams returns a fingerprint of a source and the options affecting
+// the JPEG quality searched for it. Formats and lossless `cjpeg` options do
+// not change pixels, the search is kept when they change unless the
+// encoder does.
+func qualityParams(sha string, o Options) string {
+	cjpeg := usesCJPEG(o.JPEG)
+	o.Formats = nil
+	o.JPEG.Progressive = false
+	o.JPEG.Optimize = false
+	hash := sha1.Sum([]byte(sha + fmt.Sprintf("%+v %t", o, cjpeg)))
+	return hex.EncodeToString(hash[:])[:20]
+}
@@ -0,0 +1,417 @@
+package internal
+
+import (
+	"fmt"
+	"image"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/gosimple/slug"
+	"github.com/spf13/viper"
+)
+
+// Target is a photo directory processed into an output prefix
+type Target struct {
+	Path   string
+	Prefix string
+	Sizes  []int
+}
+
+// Pipeline processes photos concurrently with a bounded number of workers
+type Pipeline struct {
+	workers  chan struct{}
+	threads  int // SQIP goroutines of each photo
+	progress *Progress
+	store    *manifest
+	shared   *sharedCache // nil unless `cache.shared` is set
+	keys     sync.Map     // *sync.Mutex of each store key being generated
+}
+
+// manifest of an output prefix, shared by the workers of a directory
+type manifest struct {
+	sync.Mutex
+	config *viper.Viper
+}
+
+// NewPipeline running at most jobs photos at once, progress may be nil
+func NewPipeline(jobs int, progress *Progress) *Pipeline {
+	if jobs < 1 {
+		jobs = 1
+	}
+	// concurrent photos already use the CPUs
+	threads := 1
+	if jobs == 1 {
+		threads = runtime.NumCPU()
+	}
+	return &Pipeline{
+		workers:  make(chan struct{}, jobs),
+		threads:  threads,
+		progress: progress,
+		store:    &manifest{config: GetManifest("store")},
+		shared:   getSharedCache(),
+	}
+}
+
+// ResizeAll processes targets concurrently, sharing the workers. Photos that
+// cannot be processed are skipped and returned as errors.
+func (p *Pipeline) ResizeAll(author string, targets []Target) []error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	for _, t := range targets {
+		wg.Add(1)
+		go func(t Target) {
+			defer wg.Done()
+			failed := p.Resize(t.Path, author, t.Prefix, t.Sizes)
+			mu.Lock()
+			errs = append(errs, failed...)
+			mu.Unlock()
+		}(t)
+	}
+	wg.Wait()
+
+	if err := p.shared.evict(); err != nil {
+		fmt.Fprintf(os.Stderr, "shared cache: %v\n", err)
+	}
+
+	return errs
+}
+
+// Resize processes every photo of a directory and updates its manifests
+func (p *Pipeline) Resize(inPath, author, outPrefix string, sizes []int) []error {
+	opts := GetOptions()
+	strategy := GetPlaceholder()
+	prefix := slug.Make(outPrefix)
+	fit := GetFit(prefix)
+
+	m := &manifest{config: GetManifest(prefix)}
+
+	var (
+		wg   sync.WaitGroup
+		errs []error
+	)
+	for _, photo := range GetPhotos(inPath) {
+		wg.Add(1)
+		p.workers <- struct{}{}
+		go func(photo string) {
+			defer func() {
+				<-p.workers
+				wg.Done()
+			}()
+			if err := p.resizePhoto(m, photo, author, prefix, sizes, fit, opts, strategy); err != nil {
+				m.Lock()
+				errs = append(errs, fmt.Errorf("%s: %v", photo, err))
+				m.Unlock()
+			}
+			p.progress.Done(prefix + "/" + filepath.Base(photo))
+		}(photo)
+	}
+	wg.Wait()
+
+	if err := writeManifest(m.config, prefix); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", prefix, err)
+	}
+	p.store.Lock()
+	defer p.store.Unlock()
+	if err := writeManifest(p.store.config, "store"); err != nil {
+		fmt.Fprintf(os.Stderr, "store: %v\n", err)
+	}
+
+	return errs
+}
+
+// resizePhoto publishes variants of a photo, generating those missing from
+// the store and the shared cache
+func (p *Pipeline) resizePhoto(m *manifest, photo, author, prefix string, sizes []int, fit string, opts Options, strategy string) error {
+	fn := slug.Make(filepath.Base(photo))
+	name := GetFileName(filepath.Base(photo), author)
+	sha := GetSHA1(photo)
+	focus := getFocus(photo)
+	crops := GetCrops(prefix)
+	opts.Alpha = photoAlpha(photo, opts.Alpha)
+	id := getPhotoID(sha, sizes, fit, crops, focus, opts)
+	key := storeKey(sha, opts)
+	search := qualityParams(sha, opts)
+
+	m.Lock()
+	// the ID changes with the photo and any setting that affects its variants
+	cached := m.config.GetString(fn+".id") == id
+	lossless, detected := m.config.GetBool(fn+".lossless"), cached && m.config.IsSet(fn+".lossless")
+	exif, hasExif := m.config.Get(fn + ".exif").(map[string]interface{})
+	color := m.config.GetString(fn + ".color")
+	previous := m.config.GetStringSlice(fn + ".store")
+	// qualities chosen for the target SSIM, kept while pixels do not change
+	qualities := map[string]int{}
+	if m.config.GetString(fn+".search") == search {
+		for k, v := range m.config.GetStringMap(fn + ".quality") {
+			switch q := v.(type) {
+			case int64:
+				qualities[k] = int(q)
+			case int:
+				qualities[k] = q
+			}
+		}
+	}
+	m.Unlock()
+
+	// graphics are told from photos once per source
+	var src image.Image
+	if !detected {
+		p.store.Lock()
+		lossless, detected = p.store.config.GetBool(key+".lossless"), p.store.config.IsSet(key+".lossless")
+		p.store.Unlock()
+	}
+	if !detected {
+		lossless, detected = p.shared.lossless(key)
+	}
+	if !detected {
+		var err error
+		if lossless, src, err = detectLossless(photo, opts); err != nil {
+			return decodeError(photo, err)
+		}
+	}
+	fallback, formats := outputFormats(lossless, opts.Formats)
+
+	// formats whose encoder failed on this source are left out until the
+	// encoder or its quality changes
+	p.store.Lock()
+	previousFailed := p.store.config.GetStringMapString(key + ".failed")
+	p.store.Unlock()
+	failed := map[string]string{}
+	for _, format := range formats {
+		params := encoderParams(format, opts.Quality[format])
+		if previousFailed[format] == params {
+			failed[format] = params
+		}
+	}
+	for format := range failed {
+		formats = dropFormat(formats, format)
+	}
+
+	var (
+		storedPaths []string
+		paths       []string
+		widths      []int
+		cropWidths  = map[string][]int{}
+		chosen      = map[string]int{}
+	)
+	// targets sharing a photo, in sections too, are processed at the same
+	// time, the first generates stored files and the others link them
+	lock := p.keyLock(key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	width, height, _ := photoDimension(photo)
+	for _, r := range renditions(key, id, prefix, name, sizes, fit, crops, focus, width, height, opts) {
+		stored, published := r.files(fallback, formats)
+		variant := qualityKey(filepath.Base(r.store))
+		if q, ok := qualities[variant]; ok {
+			chosen[variant] = q
+		}
+		storedPaths = append(storedPaths, stored...)
+		paths = append(paths, published...)
+		// real widths, smaller than requested ones for small photos
+		if r.crop == nil {
+			widths = append(widths, r.size)
+		} else {
+			cropWidths[r.crop.name] = append(cropWidths[r.crop.name], r.size)
+		}
+
+		// files deleted or truncated under `.moul/photos` are published again,
+		// as are those last linked to another stored file
+		if cached && linkedTo(previous, stored) && len(verifyPaths(published)) == 0 {
+			adopt(published, stored)
+			p.shared.put(stored)
+			continue
+		}
+		// only renditions missing from the store are generated
+		if len(verifyPaths(stored)) > 0 && !p.shared.fetch(stored) {
+			if src == nil {
+				var err error
+				if src, err = openImage(photo, opts.Profile); err != nil {
+					return decodeError(photo, err)
+				}
+			}
+			img := src
+			if r.crop != nil {
+				img = cropAround(src, *r.crop, focus)
+			}
+			// formats that failed for an earlier rendition are not retried
+			o := opts
+			o.Formats = formats
+			q, failedFormats, err := manipulate(img, photo, r, lossless, qualities[variant], o)
+			if err != nil {
+				return err
+			}
+			if q > 0 {
+				chosen[variant] = q
+			}
+			// the photo falls back to formats every rendition was encoded to
+			for _, format := range failedFormats {
+				failed[format] = encoderParams(format, opts.Quality[format])
+				formats = dropFormat(formats, format)
+				storedPaths = dropExt(storedPaths, format)
+				paths = dropExt(paths, format)
+				stored, published = dropExt(stored, format), dropExt(published, format)
+			}
+		}
+		p.shared.put(stored)
+		for i := range stored {
+			if err := linkFile(stored[i], published[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// each strategy is stored apart, switching only needs placeholders
+	if stored := storePlaceholder(key, strategy); stored != "" {
+		published := GetPlaceholderPath(id, prefix, name, strategy)
+		storedPaths = append(storedPaths, stored)
+		paths = append(paths, published)
+		if verifyFile(published) == nil {
+			adopt([]string{published}, []string{stored})
+		} else {
+			if verifyFile(stored) != nil && !p.shared.fetch([]string{stored}) {
+				if err := makePlaceholder(photo, stored, strategy, opts.Alpha.Background, p.threads); err != nil {
+					fmt.Fprintf(os.Stderr, "%s: %v\n", photo, err)
+				}
+			}
+			if err := linkFile(stored, published); err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %v\n", photo, err)
+			}
+		}
+		p.shared.put([]string{stored})
+	}
+
+	// shooting data and color only depend on the source
+	p.store.Lock()
+	if data, ok := p.store.config.Get(key + ".exif").(map[string]interface{}); ok {
+		exif, hasExif = data, true
+	}
+	if c := p.store.config.GetString(key + ".color"); c != "" {
+		color = c
+	}
+	p.store.Unlock()
+	if !hasExif || color == "" {
+		if data, c, ok := p.shared.meta(key); ok {
+			exif, hasExif, color = data, true, c
+		}
+	}
+	if !hasExif {
+		exif = map[string]interface{}{}
+		for k, v := range getShootingData(photo) {
+			exif[k] = v
+		}
+	}
+	if color == "" {
+		color, _ = GetAverageColor(photo)
+	}
+	p.shared.putMeta(key, exif, color, lossless)
+
+	p.store.Lock()
+	p.store.config.Set(key+".sha", sha)
+	p.store.config.Set(key+".params", opts.Params())
+	p.store.config.Set(key+".lossless", lossless)
+	p.store.config.Set(key+".exif", exif)
+	p.store.config.Set(key+".color", color)
+	p.store.config.Set(key+".failed", failed)
+	p.store.Unlock()
+
+	m.Lock()
+	defer m.Unlock()
+	m.config.Set(fn+".sha", sha)
+	m.config.Set(fn+".id", id)
+	m.config.Set(fn+".key", key)
+	m.config.Set(fn+".params", opts.Params())
+	m.config.Set(fn+".formats", formats)
+	m.config.Set(fn+".fallback", fallback)
+	m.config.Set(fn+".lossless", lossless)
+	m.config.Set(fn+".sizes", widths)
+	m.config.Set(fn+".focus", focus)
+	m.config.Set(fn+".crops", crops)
+	m.config.Set(fn+".cropsizes", cropWidths)
+	m.config.Set(fn+".placeholder", strategy)
+	m.config.Set(fn+".paths", paths)
+	m.config.Set(fn+".store", storedPaths)
+	m.config.Set(fn+".exif", exif)
+	m.config.Set(fn+".search", search)
+	m.config.Set(fn+".quality", chosen)
+	if color != "" {
+		m.config.Set(fn+".color", color)
+	}
+
+	return nil
+}
+
+// qualityKey of a stored variant in the manifest, viper nests keys at dots
+// like those of sharpening amounts
+func qualityKey(variant string) string {
+	return strings.Replace(variant, ".", "_", -1)
+}
+
+// keyLock returns the lock serializing the generation of a store key
+func (p *Pipeline) keyLock(key string) *sync.Mutex {
+	lock, _ := p.keys.LoadOrStore(key, &sync.Mutex{})
+	return lock.(*sync.Mutex)
+}
+
+// linkedTo reports whether published files were linked to given stored
+// ones, always for caches written before the store existed
+func linkedTo(previous, stored []string) bool {
+	if len(previous) == 0 {
+		return true
+	}
+	for _, s := range stored {
+		if !containsPath(previous, s) {
+			return false
+		}
+	}
+	return true
+}
+
+// dropFormat removes a format whose encoder failed from those of a photo
+func dropFormat(formats []string, format string) []string {
+	var kept []string
+	for _, f := range formats {
+		if f != format {
+			kept = append(kept, f)
+		}
+	}
+	return kept
+}
+
+// dropExt removes files of given format
+func dropExt(paths []string, format string) []string {
+	var kept []string
+	for _, p := range paths {
+		if filepath.Ext(p) != "."+format {
+			kept = append(kept, p)
+		}
+	}
+	return kept
+}
+
+func containsPath(paths []string, path string) bool {
+	for _, p := range paths {
+		if p == path {
+			return true
+		}
+	}
+	return false
+}
+
+// adopt links published files missing from the store into it, variants
+// published before the store existed are not generated again. Broken
+// stored files are unlinked first, never overwritten.
+func adopt(published, stored []string) {
+	for i := range stored {
+		if verifyFile(stored[i]) != nil {
+			linkFile(published[i], stored[i])
+		}
+	}
+}
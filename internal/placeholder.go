@@ -0,0 +1,129 @@
+package internal
+
+import (
+	"image"
+	"image/png"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+
+	"github.com/disintegration/imaging"
+	"github.com/fatih/color"
+)
+
+// BlankPlaceholder is a transparent gif used when `image.placeholder` is `none`
+const BlankPlaceholder = "data:image/gif;base64,R0lGODlhAQABAAAAACH5BAEKAAEALAAAAAABAAEAAAICTAEAOw=="
+
+// cached file extension of each placeholder strategy
+var placeholders = map[string]string{
+	"sqip":      "svg",
+	"blurhash":  "png",
+	"thumbhash": "png",
+	"lqip":      "jpg",
+}
+
+var placeholderWarned sync.Map
+
+// GetPlaceholder returns the configured placeholder strategy
+func GetPlaceholder() string {
+	strategy := GetConfig().GetString("image.placeholder")
+	if _, ok := placeholders[strategy]; !ok && strategy != "none" {
+		if _, warned := placeholderWarned.LoadOrStore(strategy, true); !warned {
+			color.Yellow("Unknown placeholder `%s`, using `sqip`", strategy)
+		}
+		return "sqip"
+	}
+	return strategy
+}
+
+// placeholder file of a photo relative to `.moul`, empty for `none`
+func placeholderFile(uid, prefix, name, strategy string) string {
+	// caches written before strategies were selectable only had SQIP
+	if strategy == "" {
+		strategy = "sqip"
+	}
+	ext, ok := placeholders[strategy]
+	if !ok {
+		return ""
+	}
+	return path.Join("photos", uid, prefix, strategy, name+"."+ext)
+}
+
+// GetPlaceholderPath returns cached placeholder of a photo, empty for `none`
+func GetPlaceholderPath(uid, prefix, name, strategy string) string {
+	file := placeholderFile(uid, prefix, name, strategy)
+	if file == "" {
+		return ""
+	}
+	return filepath.Join(".moul", filepath.FromSlash(file))
+}
+
+// GetPlaceholderURL returns exported placeholder of a photo
+func GetPlaceholderURL(uid, prefix, name, strategy string) string {
+	file := placeholderFile(uid, prefix, name, strategy)
+	if file == "" {
+		return BlankPlaceholder
+	}
+	return file
+}
+
+// makePlaceholder renders the placeholder of given strategy to out,
+// transparent areas are flattened onto background unless the strategy keeps
+// alpha. It is written under a temporary name, stored placeholders may be
+// linked elsewhere. SQIP runs on given number of goroutines.
+func makePlaceholder(inPath, out, strategy, background string, workers int) error {
+	if out == "" {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(out), 0755); err != nil {
+		return err
+	}
+	tmp, err := tempPath(out)
+	if err != nil {
+		return err
+	}
+	if err := renderPlaceholder(inPath, tmp, strategy, background, workers); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, out)
+}
+
+func renderPlaceholder(inPath, out, strategy, background string, workers int) error {
+	// placeholders and colors are shown next to CSS, always sRGB
+	src, err := openImage(inPath, "srgb")
+	if err != nil {
+		return err
+	}
+	if strategy != "thumbhash" {
+		src = flatten(src, background)
+	}
+	if strategy == "sqip" {
+		return makeSQIP(src, out, workers)
+	}
+
+	var img image.Image
+	switch strategy {
+	case "blurhash":
+		thumb := imaging.Fit(src, 64, 64, imaging.Box)
+		x, y := 4, 3
+		if thumb.Bounds().Dy() > thumb.Bounds().Dx() {
+			x, y = 3, 4
+		}
+		w, h := 32, 32*thumb.Bounds().Dy()/thumb.Bounds().Dx()
+		if h > 32 {
+			w, h = 32*thumb.Bounds().Dx()/thumb.Bounds().Dy(), 32
+		}
+		img, err = decodeBlurHash(encodeBlurHash(thumb, x, y), maxInt(w, 1), maxInt(h, 1))
+	case "thumbhash":
+		img, err = decodeThumbHash(encodeThumbHash(imaging.Fit(src, 100, 100, imaging.Box)))
+	case "lqip":
+		return imaging.Save(imaging.Resize(src, 32, 0, imaging.Box), out, imaging.JPEGQuality(60))
+	}
+	if err != nil {
+		return err
+	}
+
+	return imaging.Save(img, out, imaging.PNGCompressionLevel(png.BestCompression))
+}
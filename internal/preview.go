@@ -0,0 +1,36 @@
+package internal
+
+import (
+	"net/http"
+	"path"
+	"path/filepath"
+
+	"github.com/disintegration/imaging"
+)
+
+// formats browsers do not all display, preview transcodes them to JPEG
+var previewTranscoded = map[string]bool{
+	"tiff": true,
+	"bmp":  true,
+}
+
+// PreviewHandler serves photos of dir, transcoding those browsers cannot
+// display
+func PreviewHandler(dir string) http.Handler {
+	files := http.FileServer(http.Dir(dir))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		file := filepath.Join(dir, filepath.FromSlash(path.Clean("/"+r.URL.Path)))
+		if !previewTranscoded[sniffFormat(file)] {
+			files.ServeHTTP(w, r)
+			return
+		}
+		img, err := openImage(file, "srgb")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnsupportedMediaType)
+			return
+		}
+		w.Header().Set("Content-Type", "image/jpeg")
+		w.Header().Set("Cache-Control", "no-cache")
+		imaging.Encode(w, flatten(img, getAlpha().Background), imaging.JPEG, imaging.JPEGQuality(90))
+	})
+}
@@ -0,0 +1,181 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+)
+
+// EXIF tags published for each `privacy.keep` value. Anything else,
+// including maker notes, XMP and IPTC, never leaves the original.
+var privacyTags = map[string]struct {
+	ifd0, sub []uint16
+}{
+	"copyright": {ifd0: []uint16{0x8298}},
+	"artist":    {ifd0: []uint16{0x013b}},
+	"camera":    {ifd0: []uint16{tagMake, tagModel}},
+	"lens":      {sub: []uint16{0xa432, 0xa433, tagLensModel}},
+	"exposure": {sub: []uint16{
+		tagExposureTime, tagFNumber, 0x8822, tagISO, 0x9201, 0x9202,
+		0x9204, 0x9207, 0x9209, tagFocalLength, 0xa405,
+	}},
+	"date":   {ifd0: []uint16{0x0132}, sub: []uint16{tagDateTimeOriginal, 0x9004, 0x9010, 0x9011}},
+	"owner":  {sub: []uint16{0xa430}},
+	"serial": {sub: []uint16{0xa431, 0xa435}},
+}
+
+// keptExif returns the source EXIF reduced to the `privacy.keep` fields,
+// nil when nothing is left
+func keptExif(inPath string, keep []string) *exif {
+	src, err := readExif(inPath)
+	if err != nil {
+		return nil
+	}
+
+	e := &exif{
+		order: src.order,
+		ifd0:  map[uint16]exifTag{},
+		sub:   map[uint16]exifTag{},
+		gps:   map[uint16]exifTag{},
+	}
+	for _, field := range keep {
+		if field == "gps" {
+			e.gps = src.gps
+			continue
+		}
+		for _, id := range privacyTags[field].ifd0 {
+			if tag, ok := src.ifd0[id]; ok {
+				e.ifd0[id] = tag
+			}
+		}
+		for _, id := range privacyTags[field].sub {
+			if tag, ok := src.sub[id]; ok {
+				e.sub[id] = tag
+			}
+		}
+	}
+
+	if len(e.ifd0) == 0 && len(e.sub) == 0 && len(e.gps) == 0 {
+		return nil
+	}
+	return e
+}
+
+// writeExif inserts an APP1 Exif segment right after SOI of a JPEG file
+func writeExif(path string, e *exif) error {
+	jpg, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	payload := append([]byte("Exif\x00\x00"), e.encode()...)
+	if len(payload)+2 > 0xffff || len(jpg) < 2 {
+		return nil
+	}
+
+	var out bytes.Buffer
+	out.Write(jpg[:2])
+	out.Write([]byte{0xff, 0xe1})
+	binary.Write(&out, binary.BigEndian, uint16(len(payload)+2))
+	out.Write(payload)
+	out.Write(jpg[2:])
+
+	return replaceFile(path, out.Bytes())
+}
+
+// writeWebPExif appends an EXIF chunk to a WebP file, simple files are
+// converted to the extended format which carries metadata
+func writeWebPExif(path string, e *exif) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if !matchMagic(data, "RIFF????WEBPVP8") {
+		return errors.New("not a WebP image")
+	}
+
+	var body bytes.Buffer
+	chunks := data[12:]
+	if string(chunks[:4]) == "VP8X" {
+		body.Write(chunks)
+		body.Bytes()[8] |= 0x08
+	} else {
+		config, _, err := image.DecodeConfig(bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		var flags byte
+		// lossless bitstreams tell whether alpha is used
+		if string(chunks[:4]) == "VP8L" && len(chunks) >= 13 && binary.LittleEndian.Uint32(chunks[9:])&(1<<28) != 0 {
+			flags |= 0x10
+		}
+		body.WriteString("VP8X")
+		binary.Write(&body, binary.LittleEndian, uint32(10))
+		body.Write([]byte{flags | 0x08, 0, 0, 0})
+		w, h := config.Width-1, config.Height-1
+		body.Write([]byte{byte(w), byte(w >> 8), byte(w >> 16), byte(h), byte(h >> 8), byte(h >> 16)})
+		body.Write(chunks)
+	}
+
+	payload := e.encode()
+	body.WriteString("EXIF")
+	binary.Write(&body, binary.LittleEndian, uint32(len(payload)))
+	body.Write(payload)
+	if len(payload)%2 == 1 {
+		body.WriteByte(0)
+	}
+
+	var out bytes.Buffer
+	out.WriteString("RIFF")
+	binary.Write(&out, binary.LittleEndian, uint32(4+body.Len()))
+	out.WriteString("WEBP")
+	out.Write(body.Bytes())
+
+	return replaceFile(path, out.Bytes())
+}
+
+// hasGPS looks for GPS tags anywhere in a file, whatever the container
+func hasGPS(path string) bool {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return false
+	}
+
+	// XMP stores location as plain text
+	if bytes.Contains(data, []byte("exif:GPSLatitude")) || bytes.Contains(data, []byte("exif:GPSLongitude")) {
+		return true
+	}
+	for _, header := range []string{"II*\x00", "MM\x00*"} {
+		for i := 0; i < len(data); {
+			n := bytes.Index(data[i:], []byte(header))
+			if n < 0 {
+				break
+			}
+			if e, err := parseTIFF(data[i+n:]); err == nil && len(e.gps) > 0 {
+				return true
+			}
+			i += n + 1
+		}
+	}
+	return false
+}
+
+// VerifyPrivacy returns images about to be published that still carry GPS
+// tags
+func VerifyPrivacy(paths []string) []string {
+	var leaks []string
+	for _, path := range paths {
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".jpg", ".jpeg", ".png", ".webp", ".avif", ".gif", ".tif", ".tiff":
+			if hasGPS(path) {
+				leaks = append(leaks, path)
+			}
+		}
+	}
+
+	return leaks
+}
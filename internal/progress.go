@@ -0,0 +1,58 @@
+package internal
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// Progress reports processed photos and the estimated time left
+type Progress struct {
+	mu    sync.Mutex
+	out   io.Writer
+	total int
+	done  int
+	start time.Time
+}
+
+// NewProgress of given number of photos
+func NewProgress(out io.Writer, total int) *Progress {
+	p := &Progress{out: out, total: total, start: time.Now()}
+	p.print("")
+	return p
+}
+
+// Done marks a photo as processed
+func (p *Progress) Done(photo string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.done++
+	p.print(photo)
+}
+
+// Stop ends the progress line
+func (p *Progress) Stop() {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	fmt.Fprint(p.out, "\r\033[K")
+}
+
+func (p *Progress) print(photo string) {
+	line := fmt.Sprintf("■ Processing photos %d/%d", p.done, p.total)
+	if p.done > 0 && p.done < p.total {
+		elapsed := time.Since(p.start)
+		eta := elapsed / time.Duration(p.done) * time.Duration(p.total-p.done)
+		line += fmt.Sprintf(" · ETA %s", eta.Round(time.Second))
+	}
+	if photo != "" {
+		line += " · " + photo
+	}
+	fmt.Fprint(p.out, "\r\033[K"+line)
+}
@@ -0,0 +1,234 @@
+package internal
+
+import (
+	"image"
+	"math"
+	"strconv"
+	"sync"
+
+	"github.com/disintegration/imaging"
+	"github.com/fatih/color"
+)
+
+// ResampleOptions of `[image.resample]`
+type ResampleOptions struct {
+	Filter  string
+	Linear  bool            // resize in linear light
+	Radius  float64         // unsharp mask radius in pixels
+	Sharpen map[int]float64 // unsharp mask amount per configured width
+}
+
+var resampleFilters = map[string]imaging.ResampleFilter{
+	"lanczos":    imaging.Lanczos,
+	"catmullrom": imaging.CatmullRom,
+	"mitchell":   imaging.MitchellNetravali,
+	"linear":     imaging.Linear,
+	"box":        imaging.Box,
+}
+
+var resampleWarned sync.Map
+
+// getResample reads `[image.resample]`
+func getResample() ResampleOptions {
+	config := GetConfig()
+	o := ResampleOptions{
+		Filter:  config.GetString("image.resample.filter"),
+		Linear:  config.GetBool("image.resample.linear"),
+		Radius:  config.GetFloat64("image.resample.radius"),
+		Sharpen: map[int]float64{},
+	}
+	if _, ok := resampleFilters[o.Filter]; !ok {
+		if _, warned := resampleWarned.LoadOrStore("filter", true); !warned {
+			color.Yellow("Unknown resample filter `%s`, using `lanczos`", o.Filter)
+		}
+		o.Filter = "lanczos"
+	}
+	if o.Radius <= 0 {
+		o.Radius = 0.6
+	}
+
+	for k, v := range config.GetStringMap("image.resample.sharpen") {
+		width, err := strconv.Atoi(k)
+		var amount float64
+		switch n := v.(type) {
+		case float64:
+			amount = n
+		case int64:
+			amount = float64(n)
+		default:
+			amount = -1
+		}
+		if err != nil || width <= 0 || amount < 0 {
+			if _, warned := resampleWarned.LoadOrStore("sharpen."+k, true); !warned {
+				color.Yellow("Invalid `image.resample.sharpen.%s`, expected a width and a positive amount", k)
+			}
+			continue
+		}
+		if amount > 0 {
+			o.Sharpen[width] = amount
+		}
+	}
+
+	return o
+}
+
+// resample resizes a photo to given width, sharpening it by amount
+func resample(src image.Image, width int, amount float64, o ResampleOptions) *image.NRGBA {
+	filter := resampleFilters[o.Filter]
+	var dst *image.NRGBA
+	if o.Linear {
+		dst = resizeLinear(src, width, filter)
+	} else {
+		dst = imaging.Resize(src, width, 0, filter)
+	}
+	if amount > 0 {
+		dst = unsharpMask(dst, o.Radius, amount)
+	}
+	return dst
+}
+
+// unsharpMask adds the difference between the image and its blur, amount 1
+// doubles the contrast of edges
+func unsharpMask(img *image.NRGBA, radius, amount float64) *image.NRGBA {
+	blurred := imaging.Blur(img, radius)
+	dst := imaging.Clone(img)
+	for i := range dst.Pix {
+		// alpha is kept
+		if i%4 == 3 {
+			continue
+		}
+		v := float64(img.Pix[i]) + amount*(float64(img.Pix[i])-float64(blurred.Pix[i]))
+		dst.Pix[i] = uint8(math.Max(0, math.Min(255, v+0.5)))
+	}
+	return dst
+}
+
+// sRGB transfer function, Display P3 shares it
+var toLinear [256]float32
+var toSRGB [4096]uint8
+
+func init() {
+	for i := range toLinear {
+		v := float64(i) / 255
+		if v <= 0.04045 {
+			toLinear[i] = float32(v / 12.92)
+		} else {
+			toLinear[i] = float32(math.Pow((v+0.055)/1.055, 2.4))
+		}
+	}
+	for i := range toSRGB {
+		v := float64(i) / float64(len(toSRGB)-1)
+		if v <= 0.0031308 {
+			v *= 12.92
+		} else {
+			v = 1.055*math.Pow(v, 1/2.4) - 0.055
+		}
+		toSRGB[i] = uint8(v*255 + 0.5)
+	}
+}
+
+func encodeSRGB(v float32) uint8 {
+	if v <= 0 {
+		return 0
+	}
+	if v >= 1 {
+		return 255
+	}
+	return toSRGB[int(v*float32(len(toSRGB)-1)+0.5)]
+}
+
+// resizeLinear resizes to given width in linear light, averaging gamma
+// encoded values darkens high contrast edges and fine detail
+func resizeLinear(img image.Image, width int, filter imaging.ResampleFilter) *image.NRGBA {
+	src := imaging.Clone(img)
+	w, h := src.Rect.Dx(), src.Rect.Dy()
+	height := int(float64(h)*float64(width)/float64(w) + 0.5)
+	if height < 1 {
+		height = 1
+	}
+
+	// horizontal pass into premultiplied linear values
+	tmp := make([]float32, width*h*4)
+	xs := resampleWeights(w, width, filter)
+	for y := 0; y < h; y++ {
+		row := src.Pix[y*src.Stride:]
+		for x, wt := range xs {
+			var r, g, b, a float32
+			for k, c := range wt.coeffs {
+				i := (wt.start + k) * 4
+				ca := float32(row[i+3]) / 255 * c
+				r += toLinear[row[i]] * ca
+				g += toLinear[row[i+1]] * ca
+				b += toLinear[row[i+2]] * ca
+				a += ca
+			}
+			o := (y*width + x) * 4
+			tmp[o], tmp[o+1], tmp[o+2], tmp[o+3] = r, g, b, a
+		}
+	}
+
+	// vertical pass row by row, back to gamma encoded values
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	acc := make([]float32, width*4)
+	for y, wt := range resampleWeights(h, height, filter) {
+		for i := range acc {
+			acc[i] = 0
+		}
+		for k, c := range wt.coeffs {
+			row := tmp[(wt.start+k)*width*4:]
+			for i := range acc {
+				acc[i] += row[i] * c
+			}
+		}
+		out := dst.Pix[y*dst.Stride:]
+		for x := 0; x < width; x++ {
+			i := x * 4
+			a := acc[i+3]
+			if a <= 0 {
+				continue
+			}
+			out[i] = encodeSRGB(acc[i] / a)
+			out[i+1] = encodeSRGB(acc[i+1] / a)
+			out[i+2] = encodeSRGB(acc[i+2] / a)
+			out[i+3] = uint8(math.Min(255, float64(a)*255+0.5))
+		}
+	}
+	return dst
+}
+
+// weights of the source pixels mixed into a destination pixel
+type weights struct {
+	start  int
+	coeffs []float32
+}
+
+func resampleWeights(srcLen, dstLen int, filter imaging.ResampleFilter) []weights {
+	scale := float64(srcLen) / float64(dstLen)
+	// the filter widens when downscaling to cover every source pixel
+	du := math.Max(scale, 1)
+	support := filter.Support * du
+
+	out := make([]weights, dstLen)
+	for i := range out {
+		center := (float64(i)+0.5)*scale - 0.5
+		start := clampInt(int(math.Ceil(center-support)), 0, srcLen-1)
+		end := clampInt(int(math.Floor(center+support)), 0, srcLen-1)
+
+		var sum float64
+		coeffs := make([]float32, 0, end-start+1)
+		for j := start; j <= end; j++ {
+			v := filter.Kernel((float64(j) - center) / du)
+			coeffs = append(coeffs, float32(v))
+			sum += v
+		}
+		if sum == 0 {
+			start, coeffs = clampInt(int(math.Round(center)), 0, srcLen-1), []float32{1}
+		} else {
+			for k := range coeffs {
+				coeffs[k] /= float32(sum)
+			}
+		}
+		out[i] = weights{start, coeffs}
+	}
+	return out
+}
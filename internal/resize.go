@@ -4,27 +4,37 @@ import (
 	"fmt"
 	"image"
 	"log"
+	"math"
 	"os"
+	"path"
 	"path/filepath"
 	"strconv"
 	"strings"
 
-	"github.com/disintegration/imaging"
 	"github.com/gosimple/slug"
-	"github.com/spf13/viper"
 )
 
 // Collection struct
 type Collection struct {
-	ID       string `json:"id"`
-	Name     string `json:"name"`
-	Src      string `json:"src"`
-	Color    string `json:"color"`
-	SrcHd    string `json:"src_hd"`
-	Width    int    `json:"width"`
-	WidthHd  int    `json:"width_hd"`
-	Height   int    `json:"height"`
-	HeightHd int    `json:"height_hd"`
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Src         string   `json:"src"`
+	Color       string   `json:"color"`
+	Placeholder string   `json:"placeholder,omitempty"`
+	SrcHd       string   `json:"src_hd"`
+	Srcset      string   `json:"srcset,omitempty"`
+	Width       int      `json:"width"`
+	WidthHd     int      `json:"width_hd"`
+	Height      int      `json:"height"`
+	HeightHd    int      `json:"height_hd"`
+	Sources     []Source `json:"sources,omitempty"`
+	Exif        *Exif    `json:"exif,omitempty"`
+}
+
+// Source struct
+type Source struct {
+	Type   string `json:"type"`
+	Srcset string `json:"srcset"`
 }
 
 // get file path
@@ -32,48 +42,174 @@ func getFilePath(uid, prefix string, size int) string {
 	return filepath.Join(".moul", "photos", uid, prefix, strconv.Itoa(size))
 }
 
+// GetPhotoURL returns exported path of a variant
+func GetPhotoURL(uid, prefix, name, format string, size int) string {
+	return path.Join("photos", uid, prefix, strconv.Itoa(size), name+"."+format)
+}
+
+// GetSrcset returns srcset of all variants in given format
+func GetSrcset(uid, prefix, name, format string, sizes []int) string {
+	var srcset []string
+	for _, size := range sizes {
+		srcset = append(srcset, fmt.Sprintf("%s %dw", GetPhotoURL(uid, prefix, name, format, size), size))
+	}
+	return strings.Join(srcset, ", ")
+}
+
+// GetClosestSize returns the size nearest to given width
+func GetClosestSize(sizes []int, width int) int {
+	closest := 0
+	for _, size := range sizes {
+		if closest == 0 || math.Abs(float64(size-width)) < math.Abs(float64(closest-width)) {
+			closest = size
+		}
+	}
+	return closest
+}
+
 // GetFileName func
 func GetFileName(fn, author string) string {
 	return slug.Make(strings.TrimSuffix(fn, filepath.Ext(fn))) + "-by-" + slug.Make(author)
 }
 
-// GetPhotoDimension given path
+// GetPhotoDimension given path, as displayed after EXIF orientation
 func GetPhotoDimension(path string) (int, int) {
-	file, err := os.Open(path)
+	width, height, err := photoDimension(path)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "%v\n", err)
+		fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
 	}
+	return width, height
+}
 
-	image, _, err := image.DecodeConfig(file)
+// photoDimension reads the dimensions of a photo once oriented, 0 when they
+// cannot be read
+func photoDimension(path string) (int, int, error) {
+	file, err := os.Open(path)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+		return 0, 0, err
 	}
+	defer file.Close()
 
-	return image.Width, image.Height
+	config, _, err := image.DecodeConfig(file)
+	if err != nil {
+		return 0, 0, err
+	}
+	// orientations 5 to 8 are rotated by 90 or 270 degrees
+	if getOrientation(path) >= 5 {
+		return config.Height, config.Width, nil
+	}
+	return config.Width, config.Height, nil
 }
 
-// Manipulate image
-func manipulate(id, inPath, author, photoType string, size int) {
-	src, err := imaging.Open(inPath)
-	if err != nil {
-		log.Fatal(err)
+// manipulate resizes a photo to the width of a rendition and encodes it to
+// the stored fallback, JPEG or PNG when lossless, and every other format.
+// Each format is stored apart, only those missing from the store are
+// encoded.
+// Files are written under temporary names and renamed, stored files may be
+// linked into other collections and the shared cache.
+// With a target SSIM the JPEG quality is searched unless a previously chosen
+// one is given, it returns the quality used and formats whose encoder
+// failed, which are not stored.
+func manipulate(src image.Image, inPath string, r rendition, lossless bool, quality int, opts Options) (int, []string, error) {
+	fallback, formats := outputFormats(lossless, opts.Formats)
+	var missing []string
+	for _, ext := range append([]string{fallback}, formats...) {
+		if verifyFile(r.store+"."+ext) != nil {
+			missing = append(missing, ext)
+		}
 	}
+	if err := os.MkdirAll(filepath.Dir(r.store), 0755); err != nil {
+		return 0, nil, err
+	}
+	tmp := map[string]string{}
+	defer func() {
+		for _, path := range tmp {
+			os.Remove(path)
+		}
+	}()
+	for _, ext := range missing {
+		path, err := tempPath(r.store + "." + ext)
+		if err != nil {
+			return 0, nil, err
+		}
+		tmp[ext] = path
+	}
+	out, encodeFallback := tmp[fallback]
 
-	fn := filepath.Base(inPath)
-	name := GetFileName(fn, author)
+	// settings per size apply to the configured sizes
+	var newImage image.Image = resample(src, r.size, opts.Resample.Sharpen[r.requested], opts.Resample)
+	if !lossless {
+		// JPEG has no alpha, transparent areas would turn black
+		newImage = flatten(newImage, opts.Alpha.Background)
+	}
+	if opts.Watermark.applies(r.requested) {
+		marked, err := drawWatermark(newImage, opts.Watermark)
+		if err != nil {
+			return 0, nil, err
+		}
+		newImage = marked
+	}
 
-	dir := getFilePath(id, photoType, size)
-	out := filepath.Join(dir, name+".jpg")
-	if err := os.MkdirAll(dir, 0755); err != nil {
-		log.Fatal(err)
+	icc := targetICC(opts.Profile)
+	e := keptExif(inPath, opts.Keep)
+	switch {
+	case !encodeFallback:
+		// only other formats are missing
+	case lossless:
+		if err := savePNG(newImage, out, icc, e); err != nil {
+			return 0, nil, err
+		}
+	default:
+		if opts.JPEG.Target > 0 {
+			if quality == 0 {
+				quality = searchQuality(newImage, opts.JPEG)
+			}
+			opts.JPEG.Quality = quality
+		}
+		if err := saveJPEG(newImage, out, opts.JPEG); err != nil {
+			return 0, nil, err
+		}
+		if icc != nil {
+			if err := writeICC(out, icc); err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %v\n", out, err)
+			}
+		}
+		if e != nil {
+			if err := writeExif(out, e); err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %v\n", out, err)
+			}
+		}
 	}
 
-	newImage := imaging.Resize(src, size, 0, imaging.Lanczos)
+	var failed []string
+	for _, format := range formats {
+		if _, ok := tmp[format]; !ok {
+			continue
+		}
+		if err := encode(newImage, format, tmp[format], opts.Quality[format], icc, e, lossless); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %s: %v\n", inPath, format, err)
+			failed = append(failed, format)
+		}
+	}
 
-	err = imaging.Save(newImage, out)
-	if err != nil {
-		log.Fatal(err)
+	if encodeFallback {
+		if err := os.Rename(out, r.store+"."+fallback); err != nil {
+			return 0, nil, err
+		}
 	}
+	for _, format := range formats {
+		if _, ok := tmp[format]; !ok || containsFormat(failed, format) {
+			continue
+		}
+		if err := os.Rename(tmp[format], r.store+"."+format); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	if lossless || opts.JPEG.Target <= 0 || !encodeFallback {
+		return 0, failed, nil
+	}
+	return quality, failed, nil
 }
 
 // GetDirs func
@@ -101,7 +237,8 @@ func GetPhotos(path string) []string {
 		if info.IsDir() {
 			return nil
 		}
-		if strings.ToLower(filepath.Ext(path)) == ".jpeg" || strings.ToLower(filepath.Ext(path)) == ".jpg" || strings.ToLower(filepath.Ext(path)) == ".png" {
+		// formats are told apart by content, extensions are often wrong
+		if sniffFormat(path) != "" {
 			photos = append(photos, path)
 		}
 		return nil
@@ -129,60 +266,3 @@ func loadImage(fileInput string) (image.Image, error) {
 
 	return img, nil
 }
-
-// Resize func
-func Resize(inPath, author, outPrefix string, sizes []int) {
-	unique := UniqueID()
-
-	photos := GetPhotos(inPath)
-
-	config := viper.New()
-	config.AddConfigPath(".moul")
-	config.SetConfigType("toml")
-	config.SetConfigName(slug.Make(outPrefix))
-	config.ReadInConfig()
-
-	allPhotos := viper.New()
-	allPhotos.AddConfigPath(".moul")
-	allPhotos.SetConfigType("toml")
-	allPhotos.SetConfigName("photos")
-	allPhotos.ReadInConfig()
-	ap := allPhotos.GetStringSlice(slug.Make(outPrefix))
-
-	for _, photo := range photos {
-		fn := slug.Make(filepath.Base(photo))
-
-		pt := filepath.Base(photo)
-		name := GetFileName(pt, author)
-
-		if config.GetString(fn+".sha") == GetSHA1(photo) {
-			continue
-		}
-		for _, size := range sizes {
-			manipulate(unique, photo, author, slug.Make(outPrefix), size)
-
-			ap = append(ap,
-				filepath.Join(".", ".moul", "photos",
-					unique,
-					slug.Make(outPrefix),
-					strconv.Itoa(size),
-					name+".jpg"),
-			)
-		}
-		ap = append(ap,
-			filepath.Join(".", ".moul", "photos",
-				unique,
-				slug.Make(outPrefix),
-				"sqip",
-				name+".svg"),
-		)
-		makeSQIP(unique, photo, author, slug.Make(outPrefix))
-
-		config.Set(fn+".sha", GetSHA1(photo))
-		config.Set(fn+".id", unique)
-	}
-	allPhotos.Set(slug.Make(outPrefix), ap)
-
-	config.WriteConfigAs(filepath.Join(".", ".moul", slug.Make(outPrefix)+".toml"))
-	allPhotos.WriteConfigAs(filepath.Join(".", ".moul", "photos.toml"))
-}
@@ -0,0 +1,188 @@
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// sharedCache is a user level copy of the store shared by every collection,
+// laid out the same way so entries match by content and settings. Files are
+// touched when used and the least recently used are evicted over the limit.
+type sharedCache struct {
+	dir   string
+	limit int64 // bytes
+}
+
+// getSharedCache reads `[cache]` of `moul.toml`, nil unless `shared` is set
+func getSharedCache() *sharedCache {
+	config := GetConfig()
+	if !config.GetBool("cache.shared") {
+		return nil
+	}
+
+	dir := config.GetString("cache.dir")
+	if dir == "" {
+		// $XDG_CACHE_HOME on Linux, ~/Library/Caches on macOS
+		base, err := os.UserCacheDir()
+		if err != nil {
+			return nil
+		}
+		dir = filepath.Join(base, "moul")
+	}
+	return &sharedCache{dir: dir, limit: config.GetInt64("cache.size") << 20}
+}
+
+// path of a stored file in the shared cache
+func (c *sharedCache) path(stored string) string {
+	rel, _ := filepath.Rel(filepath.Join(".moul", "store"), stored)
+	return filepath.Join(c.dir, rel)
+}
+
+// fetch links stored files from the shared cache, it reports false unless
+// all of them are there and decode
+func (c *sharedCache) fetch(stored []string) bool {
+	if c == nil {
+		return false
+	}
+	for _, s := range stored {
+		if verifyFile(c.path(s)) != nil {
+			return false
+		}
+	}
+	for _, s := range stored {
+		if err := linkFile(c.path(s), s); err != nil {
+			return false
+		}
+		touch(c.path(s))
+	}
+	return true
+}
+
+// put shares stored files with other collections, those already shared are
+// marked as used
+func (c *sharedCache) put(stored []string) {
+	if c == nil {
+		return
+	}
+	for _, s := range stored {
+		shared := c.path(s)
+		if _, err := os.Stat(shared); err == nil {
+			touch(shared)
+		} else if verifyFile(s) == nil {
+			linkFile(s, shared)
+		}
+	}
+}
+
+// meta returns shooting data and color of a source shared by another
+// collection
+func (c *sharedCache) meta(key string) (map[string]interface{}, string, bool) {
+	if c == nil {
+		return nil, "", false
+	}
+	config := viper.New()
+	config.SetConfigFile(filepath.Join(c.dir, key, "meta.toml"))
+	if config.ReadInConfig() != nil || !config.IsSet("color") {
+		return nil, "", false
+	}
+	touch(config.ConfigFileUsed())
+
+	// empty tables are not written
+	exif, ok := config.Get("exif").(map[string]interface{})
+	if !ok {
+		exif = map[string]interface{}{}
+	}
+	return exif, config.GetString("color"), true
+}
+
+// lossless returns whether a source shared by another collection is
+// published lossless, sources are only decoded once to tell
+func (c *sharedCache) lossless(key string) (bool, bool) {
+	if c == nil {
+		return false, false
+	}
+	config := viper.New()
+	config.SetConfigFile(filepath.Join(c.dir, key, "meta.toml"))
+	if config.ReadInConfig() != nil || !config.IsSet("lossless") {
+		return false, false
+	}
+	return config.GetBool("lossless"), true
+}
+
+// putMeta shares shooting data, color and whether a source is lossless
+func (c *sharedCache) putMeta(key string, exif map[string]interface{}, color string, lossless bool) {
+	if c == nil || color == "" {
+		return
+	}
+	out := filepath.Join(c.dir, key, "meta.toml")
+	// written by older versions without `lossless`
+	if _, known := c.lossless(key); known {
+		return
+	}
+	config := viper.New()
+	config.Set("exif", exif)
+	config.Set("color", color)
+	config.Set("lossless", lossless)
+	// other collections may be reading it
+	if os.MkdirAll(filepath.Dir(out), 0755) != nil {
+		return
+	}
+	tmp, err := tempPath(out)
+	if err != nil {
+		return
+	}
+	if config.WriteConfigAs(tmp) != nil || os.Rename(tmp, out) != nil {
+		os.Remove(tmp)
+	}
+}
+
+// evict removes the least recently used files until the shared cache fits
+// its limit
+func (c *sharedCache) evict() error {
+	if c == nil {
+		return nil
+	}
+
+	type entry struct {
+		path string
+		size int64
+		used time.Time
+	}
+	var (
+		entries []entry
+		total   int64
+	)
+	err := filepath.Walk(c.dir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			entries = append(entries, entry{path, info.Size(), info.ModTime()})
+			total += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].used.Before(entries[j].used) })
+	for _, e := range entries {
+		if total <= c.limit {
+			break
+		}
+		if err := os.Remove(e.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		total -= e.size
+		// fails unless the entry is empty
+		os.Remove(filepath.Dir(e.path))
+	}
+	return nil
+}
+
+func touch(path string) {
+	now := time.Now()
+	os.Chtimes(path, now, now)
+}
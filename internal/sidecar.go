@@ -0,0 +1,29 @@
+package internal
+
+import (
+	"os"
+	"sync"
+
+	"github.com/fatih/color"
+	"github.com/spf13/viper"
+)
+
+var sidecarWarned sync.Map
+
+// readSidecar reads the settings of a photo from `<photo>.toml`, nil when
+// there is none
+func readSidecar(path string) *viper.Viper {
+	sidecar := path + ".toml"
+	if _, err := os.Stat(sidecar); err != nil {
+		return nil
+	}
+	config := viper.New()
+	config.SetConfigFile(sidecar)
+	if err := config.ReadInConfig(); err != nil {
+		if _, warned := sidecarWarned.LoadOrStore(sidecar, true); !warned {
+			color.Yellow("Skipped %s: %v", sidecar, err)
+		}
+		return nil
+	}
+	return config
+}
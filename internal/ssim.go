@@ -0,0 +1,146 @@
+package internal
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+	"io/ioutil"
+	"os"
+
+	"github.com/disintegration/imaging"
+)
+
+// searchQuality returns the lowest JPEG quality between `min_quality` and
+// `quality` whose encoding of img reaches the target SSIM, `quality` when
+// none does. Each quality is encoded like the published variant, by `cjpeg`
+// when it is used, its quantization tables differ from Go's.
+func searchQuality(img image.Image, o JPEGOptions) int {
+	ref := luma(imaging.Clone(img))
+	lo, hi := o.MinQuality, o.Quality
+	if lo < 1 || lo > hi {
+		lo = hi
+	}
+
+	// SSIM grows with quality, the lowest passing one is bisected
+	best := hi
+	for lo <= hi {
+		q := (lo + hi) / 2
+		decoded, err := roundTrip(img, o, q)
+		if err != nil {
+			return o.Quality
+		}
+		if ssim(ref, luma(imaging.Clone(decoded))) >= o.Target {
+			best, hi = q, q-1
+		} else {
+			lo = q + 1
+		}
+	}
+	return best
+}
+
+// roundTrip encodes img at given quality as saveJPEG does and decodes it
+func roundTrip(img image.Image, o JPEGOptions, quality int) (image.Image, error) {
+	if !usesCJPEG(o) {
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality}); err != nil {
+			return nil, err
+		}
+		return jpeg.Decode(&buf)
+	}
+
+	tmp, err := ioutil.TempFile("", "moul-*.jpg")
+	if err != nil {
+		return nil, err
+	}
+	tmp.Close()
+	defer os.Remove(tmp.Name())
+
+	o.Quality = quality
+	if err := saveJPEG(img, tmp.Name(), o); err != nil {
+		return nil, err
+	}
+	f, err := os.Open(tmp.Name())
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return jpeg.Decode(f)
+}
+
+// plane of luma values, compression artifacts show the most there
+type plane struct {
+	w, h int
+	pix  []float64
+}
+
+func luma(img *image.NRGBA) plane {
+	w, h := img.Rect.Dx(), img.Rect.Dy()
+	p := plane{w, h, make([]float64, w*h)}
+	for y := 0; y < h; y++ {
+		row := img.Pix[y*img.Stride:]
+		for x := 0; x < w; x++ {
+			i := x * 4
+			p.pix[y*w+x] = 0.299*float64(row[i]) + 0.587*float64(row[i+1]) + 0.114*float64(row[i+2])
+		}
+	}
+	return p
+}
+
+// ssim returns the mean structural similarity of two planes of the same
+// size, over 8×8 windows overlapping by half. 1 means identical.
+func ssim(a, b plane) float64 {
+	const (
+		c1 = (0.01 * 255) * (0.01 * 255)
+		c2 = (0.03 * 255) * (0.03 * 255)
+	)
+	// tiny variants are compared as a single window
+	window := 8
+	if a.w < window || a.h < window {
+		window = clampInt(a.w, 1, a.h)
+	}
+	step := window / 2
+	if step < 1 {
+		step = 1
+	}
+
+	var (
+		sum   float64
+		n     int
+		count = float64(window * window)
+	)
+	for y := 0; y+window <= a.h; y += step {
+		for x := 0; x+window <= a.w; x += step {
+			var ma, mb float64
+			for j := 0; j < window; j++ {
+				k := (y+j)*a.w + x
+				for i := k; i < k+window; i++ {
+					ma += a.pix[i]
+					mb += b.pix[i]
+				}
+			}
+			ma /= count
+			mb /= count
+
+			var va, vb, cov float64
+			for j := 0; j < window; j++ {
+				k := (y+j)*a.w + x
+				for i := k; i < k+window; i++ {
+					da, db := a.pix[i]-ma, b.pix[i]-mb
+					va += da * da
+					vb += db * db
+					cov += da * db
+				}
+			}
+			va /= count
+			vb /= count
+			cov /= count
+
+			sum += (2*ma*mb + c1) * (2*cov + c2) / ((ma*ma + mb*mb + c1) * (va + vb + c2))
+			n++
+		}
+	}
+	if n == 0 {
+		return 1
+	}
+	return sum / float64(n)
+}
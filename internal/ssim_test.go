@@ -0,0 +1,108 @@
+package internal
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/disintegration/imaging"
+)
+
+func testPlane(w, h int, f func(x, y int) float64) plane {
+	p := plane{w, h, make([]float64, w*h)}
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			p.pix[y*w+x] = f(x, y)
+		}
+	}
+	return p
+}
+
+func TestSSIM(t *testing.T) {
+	gradient := testPlane(32, 32, func(x, y int) float64 { return float64(x*4 + y*3) })
+	noise := rand.New(rand.NewSource(1))
+	noisy := testPlane(32, 32, func(x, y int) float64 { return gradient.pix[y*32+x] + noise.Float64()*60 - 30 })
+	inverted := testPlane(32, 32, func(x, y int) float64 { return 255 - gradient.pix[y*32+x] })
+
+	tests := []struct {
+		name     string
+		a, b     plane
+		min, max float64
+	}{
+		{"identical", gradient, gradient, 1, 1},
+		{"tiny identical", testPlane(3, 2, func(x, y int) float64 { return float64(x * y) }), testPlane(3, 2, func(x, y int) float64 { return float64(x * y) }), 1, 1},
+		{"noisy", gradient, noisy, 0.2, 0.95},
+		{"inverted", gradient, inverted, -1, 0.1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ssim(tt.a, tt.b)
+			if got < tt.min-1e-9 || got > tt.max+1e-9 {
+				t.Errorf("ssim = %g, want between %g and %g", got, tt.min, tt.max)
+			}
+			if reverse := ssim(tt.b, tt.a); math.Abs(reverse-got) > 1e-9 {
+				t.Errorf("ssim is not symmetric, %g and %g", got, reverse)
+			}
+		})
+	}
+}
+
+func TestSearchQuality(t *testing.T) {
+	flat := image.NewNRGBA(image.Rect(0, 0, 64, 64))
+	detailed := image.NewNRGBA(image.Rect(0, 0, 64, 64))
+	noise := rand.New(rand.NewSource(1))
+	for y := 0; y < 64; y++ {
+		for x := 0; x < 64; x++ {
+			flat.SetNRGBA(x, y, color.NRGBA{90, 120, 150, 255})
+			v := uint8(noise.Intn(256))
+			detailed.SetNRGBA(x, y, color.NRGBA{v, v, v, 255})
+		}
+	}
+
+	tests := []struct {
+		name     string
+		img      image.Image
+		o        JPEGOptions
+		min, max int
+	}{
+		{"flat reaches the minimum", flat, JPEGOptions{Quality: 95, MinQuality: 60, Target: 0.99, Subsampling: "4:2:0"}, 60, 60},
+		{"detailed needs more", detailed, JPEGOptions{Quality: 95, MinQuality: 60, Target: 0.99, Subsampling: "4:2:0"}, 61, 95},
+		{"unreachable target", detailed, JPEGOptions{Quality: 80, MinQuality: 60, Target: 1, Subsampling: "4:2:0"}, 80, 80},
+		{"minimum above quality", flat, JPEGOptions{Quality: 70, MinQuality: 90, Target: 0.99, Subsampling: "4:2:0"}, 70, 70},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := searchQuality(tt.img, tt.o)
+			if got < tt.min || got > tt.max {
+				t.Errorf("searchQuality = %d, want between %d and %d", got, tt.min, tt.max)
+			}
+			// the lowest passing quality, the one below fails
+			if got > tt.o.MinQuality && got < tt.o.Quality {
+				ref := luma(imaging.Clone(tt.img))
+				for q, pass := range map[int]bool{got: true, got - 1: false} {
+					decoded, err := roundTrip(tt.img, tt.o, q)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if s := ssim(ref, luma(imaging.Clone(decoded))); (s >= tt.o.Target) != pass {
+						t.Errorf("quality %d has SSIM %g, want passing %v", q, s, pass)
+					}
+				}
+			}
+		})
+	}
+
+	// a stricter target never picks a lower quality
+	o := JPEGOptions{Quality: 95, MinQuality: 30, Subsampling: "4:2:0"}
+	previous := 0
+	for _, target := range []float64{0.9, 0.95, 0.98, 0.99} {
+		o.Target = target
+		q := searchQuality(detailed, o)
+		if q < previous {
+			t.Errorf("target %g chose %d, below %d for a lower target", target, q, previous)
+		}
+		previous = q
+	}
+}
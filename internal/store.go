@@ -0,0 +1,168 @@
+package internal
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// Variants are stored once by content in `.moul/store/<key>`, the key hashes
+// the source and processing options. Published files under `.moul/photos`
+// link to the store, a renamed or moved photo only needs new links.
+
+// storeKey of a source photo processed with given options. Formats are
+// stored in files of their own, installing an encoder only adds files.
+func storeKey(sha string, opts Options) string {
+	opts.Formats = nil
+	hash := sha1.Sum([]byte(sha + opts.Params()))
+	return hex.EncodeToString(hash[:])[:20]
+}
+
+// rendition is a resized photo or crop, stored once and published under the
+// name of every file sharing its content. Paths have no extension, every
+// format shares them.
+type rendition struct {
+	size      int // width
+	requested int // configured size it was resized for
+	crop      *crop
+	store     string
+	path      string
+}
+
+// renditions of a photo published as `photos/<id>/<prefix>/<width>/<name>`.
+// Photos are never upscaled, widths fitting sizes are capped at the source
+// of each rendition, width and height, and deduplicated.
+func renditions(key, id, prefix, name string, sizes []int, fit string, crops []string, focus []float64, width, height int, opts Options) []rendition {
+	var rs []rendition
+	widths, requested := fitSizes(sizes, width, height, fit)
+	for i, size := range widths {
+		rs = append(rs, rendition{
+			size:      size,
+			requested: requested[i],
+			store:     filepath.Join(".moul", "store", key, variantName(size, requested[i], opts)),
+			path:      filepath.Join(getFilePath(id, prefix, size), name),
+		})
+	}
+	for i, c := range coverCrops {
+		if !containsCrop(crops, c.name) {
+			continue
+		}
+		cw, ch := cropSize(width, height, c)
+		widths, requested := fitSizes(sizes, cw, ch, fit)
+		for j, size := range widths {
+			// crops are cut around the focal point, a new one is stored apart
+			rs = append(rs, rendition{
+				size:      size,
+				requested: requested[j],
+				crop:      &coverCrops[i],
+				store:     filepath.Join(".moul", "store", key, fmt.Sprintf("%s-%s-%.0fx%.0f", variantName(size, requested[j], opts), c.name, focus[0]*1000, focus[1]*1000)),
+				path:      filepath.Join(getFilePath(id, prefix, size), name+"-"+c.name),
+			})
+		}
+	}
+	return rs
+}
+
+// variantName of a stored rendition, sharpening and watermarks depend on the
+// requested size and two requests capped to the same width may differ
+func variantName(size, requested int, opts Options) string {
+	name := strconv.Itoa(size)
+	if amount := opts.Resample.Sharpen[requested]; amount > 0 {
+		name += fmt.Sprintf("-sharpen%g", amount)
+	}
+	if opts.Watermark.applies(requested) {
+		name += "-marked"
+	}
+	return name
+}
+
+func containsCrop(crops []string, name string) bool {
+	for _, c := range crops {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// files of a rendition in its fallback and other formats, stored and
+// published
+func (r rendition) files(fallback string, formats []string) (store, published []string) {
+	for _, ext := range append([]string{fallback}, formats...) {
+		store = append(store, r.store+"."+ext)
+		published = append(published, r.path+"."+ext)
+	}
+	return store, published
+}
+
+// storePlaceholder returns the stored placeholder of a photo, empty for `none`
+func storePlaceholder(key, strategy string) string {
+	ext, ok := placeholders[strategy]
+	if !ok {
+		return ""
+	}
+	return filepath.Join(".moul", "store", key, "placeholder-"+strategy+"."+ext)
+}
+
+// tempPath creates an empty file next to path, renamed over it once
+// complete. Names are unique, targets sharing a stored file may write it at
+// the same time. The extension is kept for encoders that read it.
+func tempPath(path string) (string, error) {
+	f, err := ioutil.TempFile(filepath.Dir(path), ".tmp-*-"+filepath.Base(path))
+	if err != nil {
+		return "", err
+	}
+	// published files are read by web servers
+	if err := f.Chmod(0644); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), f.Close()
+}
+
+// replaceFile writes a file under a temporary name and renames it, files
+// hard linked to the previous content are left untouched
+func replaceFile(path string, data []byte) error {
+	tmp, err := tempPath(path)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// linkFile publishes a stored file, copying it where hard links are not
+// supported
+func linkFile(src, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	os.Remove(dst)
+	if err := os.Link(src, dst); err == nil {
+		return nil
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
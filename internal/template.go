@@ -44,8 +44,14 @@ func Template() string {
         <meta property="og:description" content="<%= content["text"] %>" />
         <meta name="twitter:description" content="<%= content["text"] %>">
     <% } %>
-    <meta property="og:image" content="<%= base %>photos/<%= cover["id"] %>/cover/1280/<%= cover["name"] %>.jpg" />
-    <meta name="twitter:image" content="<%= base %>photos/<%= cover["id"] %>/cover/1280/<%= cover["name"] %>.jpg" />
+    <%= if (len(cover["og"]) > 0) { %>
+        <meta property="og:image" content="<%= base %><%= cover["og"] %>" />
+        <%= if (cover["card"] == true) { %>
+            <meta property="og:image:width" content="1200" />
+            <meta property="og:image:height" content="630" />
+        <% } %>
+        <meta name="twitter:image" content="<%= base %><%= cover["og"] %>" />
+    <% } %>
     
     <style>
         :root {
@@ -303,6 +309,9 @@ func Template() string {
             font-size: 0;
             float: left;
         }
+        .moul-collection figcaption {
+            display: none;
+        }
         .pswp__bg {
             background: #090a0b !important;
         }
@@ -317,18 +326,36 @@ func Template() string {
                 <header>
                     <div class="cover">
                         <picture>
-                            <source
-                                media="(min-width: 1200px)"
-                                data-srcset="photos/<%= cover["id"] %>/cover/2560/<%= cover["name"] %>.jpg"
-                            >
-                            <source
-                                media="(min-width: 320px)"
-                                data-srcset="photos/<%= cover["id"] %>/cover/1280/<%= cover["name"] %>.jpg"
-                            >
+                            <%= for (c) in cover["crops"] { %>
+                                <%= for (f) in cover["formats"] { %>
+                                    <source
+                                        <%= if (len(cropMedia(style["cover"], c)) > 0) { %>media="<%= cropMedia(style["cover"], c) %>"<% } %>
+                                        type="<%= imageType(f) %>"
+                                        data-srcset="<%= srcset(cover["id"], "cover", cover["name"] + "-" + c, f, cover["cropSizes"][c]) %>"
+                                        data-sizes="auto"
+                                    >
+                                <% } %>
+                                <source
+                                    <%= if (len(cropMedia(style["cover"], c)) > 0) { %>media="<%= cropMedia(style["cover"], c) %>"<% } %>
+                                    type="<%= imageType(cover["fallback"]) %>"
+                                    data-srcset="<%= srcset(cover["id"], "cover", cover["name"] + "-" + c, cover["fallback"], cover["cropSizes"][c]) %>"
+                                    data-sizes="auto"
+                                >
+                            <% } %>
+                            <%= for (f) in cover["formats"] { %>
+                                <source
+                                    type="<%= imageType(f) %>"
+                                    data-srcset="<%= srcset(cover["id"], "cover", cover["name"], f, cover["sizes"]) %>"
+                                    data-sizes="auto"
+                                >
+                            <% } %>
                             <img
                                 alt="cover"
                                 class="lazyload"
-                                src="<%= cover["sqip"] %>"
+                                style="background: <%= cover["color"] %>; object-position: <%= cover["position"] %>"
+                                src="<%= cover["placeholder"] %>"
+                                data-srcset="<%= srcset(cover["id"], "cover", cover["name"], cover["fallback"], cover["sizes"]) %>"
+                                data-sizes="auto"
                             >
                         </picture>
                     </div>
@@ -342,6 +369,7 @@ func Template() string {
                             <img
                                 alt="cover"
                                 class="lazyload"
+                                style="object-position: <%= cover["position"] %>"
                                 src="photos/cover/<%= cover["name"] %>"
                             >
                         <% } else { %>
@@ -358,12 +386,24 @@ func Template() string {
         <div class="profile">
             <%= if (isProd == true) { %>
                 <%= if (len(avatar) > 0) { %>
-                    <a href="photos/<%= avatar["id"] %>/avatar/512/<%= avatar["name"] %>.jpg" class="avatar">
-                        <img
-                            src="<%= avatar["sqip"] %>"
-                            data-src="photos/<%= avatar["id"] %>/avatar/320/<%= avatar["name"] %>.jpg"
-                            class="lazyload"
-                            alt="<%= profile["name"] %>'s avatar">
+                    <a href="<%= avatar["srcHd"] %>" class="avatar">
+                        <picture>
+                            <%= for (f) in avatar["formats"] { %>
+                                <source
+                                    type="<%= imageType(f) %>"
+                                    data-srcset="<%= srcset(avatar["id"], "avatar", avatar["name"], f, avatar["sizes"]) %>"
+                                    data-sizes="auto"
+                                >
+                            <% } %>
+                            <img
+                                style="background: <%= avatar["color"] %>"
+                                src="<%= avatar["placeholder"] %>"
+                                data-src="<%= avatar["src"] %>"
+                                data-srcset="<%= srcset(avatar["id"], "avatar", avatar["name"], avatar["fallback"], avatar["sizes"]) %>"
+                                data-sizes="auto"
+                                class="lazyload"
+                                alt="<%= profile["name"] %>'s avatar">
+                        </picture>
                     </a>
                 <% } %>
             <% } else { %>
@@ -448,7 +488,7 @@ func Template() string {
     <%= for (v) in between(0,10) { %>
         <%= if (len(section) >= v) { %>
             <%= if ((len(section[toString(v)]["title"]) > 0) || (len(section[toString(v)]["description"]) > 0)){ %>
-                <section class="content-wrap">
+                <section class="content-wrap" id="<%= "section-" + toString(v) %>">
                     <%= if (len(section[toString(v)]["title"]) > 0) { %>
                         <h2><%= section[toString(v)]["title"] %></h2>
                     <% } %>
@@ -532,3 +572,34 @@ func Template() string {
 </body>
 </html>`
 }
+
+// SectionTemplate is the page shared for a section, it carries the section
+// card and sends visitors on to the section
+func SectionTemplate() string {
+	return `
+<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="utf-8">
+    <title><%= title %> by <%= profile["name"] %></title>
+    <link rel="canonical" href="<%= base %>#<%= anchor %>">
+    <meta http-equiv="refresh" content="0; url=<%= base %>#<%= anchor %>">
+    <meta name="twitter:card" content="summary_large_image" />
+    <%= if (len(social["twitter"]) > 0 ) { %>
+        <meta name="twitter:creator" content="@<%= social["twitter"] %>" />
+    <% } %>
+    <meta property="og:url" content="<%= base %><%= anchor %>/" />
+    <meta property="og:type" content="website" />
+    <meta property="og:title" content="<%= title %>" />
+    <meta name="twitter:title" content="<%= title %>" />
+    <meta property="og:image" content="<%= base %><%= card %>" />
+    <meta property="og:image:width" content="1200" />
+    <meta property="og:image:height" content="630" />
+    <meta name="twitter:image" content="<%= base %><%= card %>" />
+</head>
+<body>
+    <a href="<%= base %>#<%= anchor %>"><%= title %></a>
+</body>
+</html>
+`
+}
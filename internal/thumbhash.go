@@ -0,0 +1,270 @@
+package internal
+
+import (
+	"errors"
+	"image"
+	"math"
+)
+
+// Port of https://github.com/evanw/thumbhash
+
+// encodeThumbHash of an image that fits in 100x100
+func encodeThumbHash(img *image.NRGBA) []byte {
+	w, h := img.Bounds().Dx(), img.Bounds().Dy()
+	n := w * h
+
+	var avgR, avgG, avgB, avgA float64
+	for i := 0; i < n; i++ {
+		p := img.Pix[i*4:]
+		alpha := float64(p[3]) / 255
+		avgR += alpha / 255 * float64(p[0])
+		avgG += alpha / 255 * float64(p[1])
+		avgB += alpha / 255 * float64(p[2])
+		avgA += alpha
+	}
+	if avgA > 0 {
+		avgR /= avgA
+		avgG /= avgA
+		avgB /= avgA
+	}
+
+	hasAlpha := avgA < float64(n)
+	lLimit := 7.0
+	if hasAlpha {
+		lLimit = 5
+	}
+	longest := float64(w)
+	if h > w {
+		longest = float64(h)
+	}
+	lx := int(math.Max(1, math.Round(lLimit*float64(w)/longest)))
+	ly := int(math.Max(1, math.Round(lLimit*float64(h)/longest)))
+
+	// convert to luminance, yellow-blue, red-green and alpha channels
+	l, p, q, a := make([]float64, n), make([]float64, n), make([]float64, n), make([]float64, n)
+	for i := 0; i < n; i++ {
+		px := img.Pix[i*4:]
+		alpha := float64(px[3]) / 255
+		r := avgR*(1-alpha) + alpha/255*float64(px[0])
+		g := avgG*(1-alpha) + alpha/255*float64(px[1])
+		b := avgB*(1-alpha) + alpha/255*float64(px[2])
+		l[i] = (r + g + b) / 3
+		p[i] = (r+g)/2 - b
+		q[i] = r - g
+		a[i] = alpha
+	}
+
+	encodeChannel := func(channel []float64, nx, ny int) (float64, []float64, float64) {
+		var dc, scale float64
+		var ac []float64
+		fx := make([]float64, w)
+		for cy := 0; cy < ny; cy++ {
+			for cx := 0; cx*ny < nx*(ny-cy); cx++ {
+				for x := 0; x < w; x++ {
+					fx[x] = math.Cos(math.Pi / float64(w) * float64(cx) * (float64(x) + 0.5))
+				}
+				f := 0.0
+				for y := 0; y < h; y++ {
+					fy := math.Cos(math.Pi / float64(h) * float64(cy) * (float64(y) + 0.5))
+					for x := 0; x < w; x++ {
+						f += channel[x+y*w] * fx[x] * fy
+					}
+				}
+				f /= float64(n)
+				if cx > 0 || cy > 0 {
+					ac = append(ac, f)
+					scale = math.Max(scale, math.Abs(f))
+				} else {
+					dc = f
+				}
+			}
+		}
+		if scale > 0 {
+			for i := range ac {
+				ac[i] = 0.5 + 0.5/scale*ac[i]
+			}
+		}
+		return dc, ac, scale
+	}
+
+	lDC, lAC, lScale := encodeChannel(l, maxInt(3, lx), maxInt(3, ly))
+	pDC, pAC, pScale := encodeChannel(p, 3, 3)
+	qDC, qAC, qScale := encodeChannel(q, 3, 3)
+
+	round := func(v float64) int { return int(math.Round(v)) }
+	header24 := round(63*lDC) | round(31.5+31.5*pDC)<<6 | round(31.5+31.5*qDC)<<12 | round(31*lScale)<<18
+	header16 := round(63*pScale)<<3 | round(63*qScale)<<9
+	if hasAlpha {
+		header24 |= 1 << 23
+	}
+	if w > h {
+		header16 |= ly | 1<<15
+	} else {
+		header16 |= lx
+	}
+
+	hash := []byte{
+		byte(header24), byte(header24 >> 8), byte(header24 >> 16),
+		byte(header16), byte(header16 >> 8),
+	}
+	channels := [][]float64{lAC, pAC, qAC}
+	if hasAlpha {
+		aDC, aAC, aScale := encodeChannel(a, 5, 5)
+		hash = append(hash, byte(round(15*aDC)|round(15*aScale)<<4))
+		channels = append(channels, aAC)
+	}
+
+	start, index := len(hash), 0
+	for _, ac := range channels {
+		for _, f := range ac {
+			if start+index>>1 >= len(hash) {
+				hash = append(hash, 0)
+			}
+			hash[start+index>>1] |= byte(round(15*f) << uint((index&1)<<2))
+			index++
+		}
+	}
+
+	return hash
+}
+
+// decodeThumbHash renders a hash into an image of at most 32x32
+func decodeThumbHash(hash []byte) (*image.NRGBA, error) {
+	if len(hash) < 5 {
+		return nil, errors.New("thumbhash: invalid length")
+	}
+
+	header24 := int(hash[0]) | int(hash[1])<<8 | int(hash[2])<<16
+	header16 := int(hash[3]) | int(hash[4])<<8
+	lDC := float64(header24&63) / 63
+	pDC := float64(header24>>6&63)/31.5 - 1
+	qDC := float64(header24>>12&63)/31.5 - 1
+	lScale := float64(header24>>18&31) / 31
+	hasAlpha := header24>>23 != 0
+	pScale := float64(header16>>3&63) / 63
+	qScale := float64(header16>>9&63) / 63
+	isLandscape := header16>>15 != 0
+
+	lLimit := 7
+	if hasAlpha {
+		lLimit = 5
+	}
+	lx, ly := header16&7, lLimit
+	if isLandscape {
+		lx, ly = lLimit, header16&7
+	}
+	ratio := float64(lx) / float64(ly)
+	lx, ly = maxInt(3, lx), maxInt(3, ly)
+
+	aDC, aScale := 1.0, 0.0
+	start := 5
+	if hasAlpha {
+		if len(hash) < 6 {
+			return nil, errors.New("thumbhash: invalid length")
+		}
+		aDC = float64(hash[5]&15) / 15
+		aScale = float64(hash[5]>>4) / 15
+		start = 6
+	}
+
+	index := 0
+	decodeChannel := func(nx, ny int, scale float64) []float64 {
+		var ac []float64
+		for cy := 0; cy < ny; cy++ {
+			cx := 0
+			if cy == 0 {
+				cx = 1
+			}
+			for ; cx*ny < nx*(ny-cy); cx++ {
+				v := 0.0
+				if i := start + index>>1; i < len(hash) {
+					v = (float64(hash[i]>>uint((index&1)<<2)&15)/7.5 - 1) * scale
+				}
+				ac = append(ac, v)
+				index++
+			}
+		}
+		return ac
+	}
+	// saturation is boosted to compensate for quantization
+	lAC := decodeChannel(lx, ly, lScale)
+	pAC := decodeChannel(3, 3, pScale*1.25)
+	qAC := decodeChannel(3, 3, qScale*1.25)
+	var aAC []float64
+	if hasAlpha {
+		aAC = decodeChannel(5, 5, aScale)
+	}
+
+	w, h := int(math.Round(32*ratio)), 32
+	if ratio > 1 {
+		w, h = 32, int(math.Round(32/ratio))
+	}
+
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	fx, fy := make([]float64, 7), make([]float64, 7)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			l, p, q, a := lDC, pDC, qDC, aDC
+
+			for cx := 0; cx < maxInt(lx, 5); cx++ {
+				fx[cx] = math.Cos(math.Pi / float64(w) * (float64(x) + 0.5) * float64(cx))
+			}
+			for cy := 0; cy < maxInt(ly, 5); cy++ {
+				fy[cy] = math.Cos(math.Pi / float64(h) * (float64(y) + 0.5) * float64(cy))
+			}
+
+			for cy, j := 0, 0; cy < ly; cy++ {
+				cx := 0
+				if cy == 0 {
+					cx = 1
+				}
+				for ; cx*ly < lx*(ly-cy); cx++ {
+					l += lAC[j] * fx[cx] * fy[cy] * 2
+					j++
+				}
+			}
+			for cy, j := 0, 0; cy < 3; cy++ {
+				cx := 0
+				if cy == 0 {
+					cx = 1
+				}
+				for ; cx < 3-cy; cx++ {
+					f := fx[cx] * fy[cy] * 2
+					p += pAC[j] * f
+					q += qAC[j] * f
+					j++
+				}
+			}
+			if hasAlpha {
+				for cy, j := 0, 0; cy < 5; cy++ {
+					cx := 0
+					if cy == 0 {
+						cx = 1
+					}
+					for ; cx < 5-cy; cx++ {
+						a += aAC[j] * fx[cx] * fy[cy] * 2
+						j++
+					}
+				}
+			}
+
+			b := l - 2.0/3*p
+			r := (3*l - b + q) / 2
+			g := r - q
+			px := img.Pix[y*img.Stride+x*4:]
+			px[0] = uint8(math.Max(0, 255*math.Min(1, r)))
+			px[1] = uint8(math.Max(0, 255*math.Min(1, g)))
+			px[2] = uint8(math.Max(0, 255*math.Min(1, b)))
+			px[3] = uint8(math.Max(0, 255*math.Min(1, a)))
+		}
+	}
+
+	return img, nil
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,64 @@
+package internal
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestThumbHashRoundTrip(t *testing.T) {
+	tests := []struct {
+		name         string
+		w, h         int
+		c            color.NRGBA
+		wantW, wantH int
+	}{
+		// the ratio is rounded to that of the luma components
+		{"landscape", 100, 50, color.NRGBA{200, 120, 40, 255}, 32, 18},
+		{"portrait", 50, 100, color.NRGBA{40, 120, 200, 255}, 18, 32},
+		{"square", 60, 60, color.NRGBA{128, 128, 128, 255}, 32, 32},
+		{"transparent", 80, 40, color.NRGBA{20, 200, 90, 128}, 32, 19},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash := encodeThumbHash(solid(tt.w, tt.h, tt.c))
+			img, err := decodeThumbHash(hash)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if img.Bounds().Dx() != tt.wantW || img.Bounds().Dy() != tt.wantH {
+				t.Errorf("size = %v, want %dx%d", img.Bounds(), tt.wantW, tt.wantH)
+			}
+			// colors are quantized to 6 bits, alpha to 4
+			got := img.NRGBAAt(img.Bounds().Dx()/2, img.Bounds().Dy()/2)
+			assertColor(t, got, tt.c, 12)
+		})
+	}
+}
+
+func TestThumbHashAlphaFlag(t *testing.T) {
+	opaque := encodeThumbHash(solid(10, 10, color.NRGBA{1, 2, 3, 255}))
+	transparent := encodeThumbHash(solid(10, 10, color.NRGBA{1, 2, 3, 0}))
+	if opaque[2]&0x80 != 0 {
+		t.Error("opaque image has the alpha flag")
+	}
+	if transparent[2]&0x80 == 0 {
+		t.Error("transparent image lacks the alpha flag")
+	}
+}
+
+func TestDecodeThumbHashInvalid(t *testing.T) {
+	for name, hash := range map[string][]byte{
+		"empty": nil,
+		"short": {1, 2, 3, 4},
+		// alpha flag set without its byte
+		"alpha": {0, 0, 0x80, 0, 0},
+	} {
+		if _, err := decodeThumbHash(hash); err == nil {
+			t.Errorf("%s: decoded %v", name, hash)
+		}
+	}
+	// missing AC bytes decode as zero
+	if _, err := decodeThumbHash([]byte{0, 0, 0, 7, 0}); err != nil {
+		t.Errorf("truncated AC: %v", err)
+	}
+}
@@ -1,27 +1,24 @@
 package internal
 
 import (
-	"math/rand"
-	"os"
-	"sync"
-	"time"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
 )
 
-// Got from: https://github.com/googleapis/google-cloud-go/blob/52020a63249f2e4201e59458009f5af55acf483d/firestore/collref.go#L127-L138
-const alphanum = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
-
-var (
-	rngMu sync.Mutex
-	rng   = rand.New(rand.NewSource(time.Now().UnixNano() ^ int64(os.Getpid())))
-)
-
-// UniqueID func
-func UniqueID() string {
-	var b [20]byte
-	rngMu.Lock()
-	for i := 0; i < len(b); i++ {
-		b[i] = alphanum[rng.Intn(len(alphanum))]
+// getPhotoID derives the directory of the variants of a photo from its
+// content and everything that affects them, so the same input always gets
+// the same URLs and a changed photo gets new ones. Formats and JPEG options
+// reset without `cjpeg` depend on the tools installed, the configured ones
+// are hashed so every machine exports the same URLs.
+func getPhotoID(sha string, sizes []int, fit string, crops []string, focus []float64, opts Options) string {
+	opts.Formats = nil
+	opts.JPEG = configuredJPEG()
+	hash := sha1.New()
+	fmt.Fprint(hash, sha, opts.Params(), sizes, fit, crops)
+	// only crops are cut around the focal point
+	if len(crops) > 0 {
+		fmt.Fprint(hash, focus)
 	}
-	rngMu.Unlock()
-	return string(b[:])
+	return hex.EncodeToString(hash.Sum(nil))[:20]
 }
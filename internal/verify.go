@@ -0,0 +1,189 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/gosimple/slug"
+)
+
+// verifyFile checks a cached file exists and decodes. Headers are decoded
+// and the end of the file checked, a truncated file is reported without
+// decoding pixels.
+func verifyFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return errors.New("missing")
+		}
+		return err
+	}
+	defer f.Close()
+
+	switch filepath.Ext(path) {
+	case ".avif":
+		// no decoder in Go, an ISO BMFF file type box is expected
+		header := make([]byte, 12)
+		if _, err := io.ReadFull(f, header); err != nil || !matchMagic(header, "????ftypavi") {
+			return errors.New("not an AVIF image")
+		}
+	case ".webp":
+		// Go only decodes extended files whose sole feature is alpha, variants
+		// with a profile or EXIF are checked by structure
+		header := make([]byte, 16)
+		if _, err := io.ReadFull(f, header); err != nil || !matchMagic(header, "RIFF????WEBPVP8") {
+			return errors.New("not a WebP image")
+		}
+	case ".svg":
+		head := make([]byte, 512)
+		n, _ := io.ReadFull(f, head)
+		if !bytes.Contains(head[:n], []byte("<svg")) {
+			return errors.New("not an SVG image")
+		}
+	default:
+		if _, _, err := image.DecodeConfig(f); err != nil {
+			return err
+		}
+	}
+	return verifyEnd(f, filepath.Ext(path))
+}
+
+// verifyEnd checks a file ends where its format says it does
+func verifyEnd(f *os.File, ext string) error {
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	size := info.Size()
+	tail := make([]byte, 512)
+	if size < int64(len(tail)) {
+		tail = tail[:size]
+	}
+	if _, err := f.ReadAt(tail, size-int64(len(tail))); err != nil {
+		return err
+	}
+
+	truncated := errors.New("truncated")
+	switch ext {
+	case ".jpg", ".jpeg":
+		// EOI marker, some encoders pad after it
+		if !bytes.HasSuffix(bytes.TrimRight(tail, "\x00"), []byte{0xff, 0xd9}) {
+			return truncated
+		}
+	case ".png":
+		if !bytes.HasSuffix(tail, []byte("\x00\x00\x00\x00IEND\xae\x42\x60\x82")) {
+			return truncated
+		}
+	case ".webp":
+		// the RIFF header holds the size of the rest of the file
+		header := make([]byte, 8)
+		if _, err := f.ReadAt(header, 0); err != nil {
+			return err
+		}
+		if int64(binary.LittleEndian.Uint32(header[4:]))+8 != size {
+			return truncated
+		}
+	case ".avif":
+		// top level boxes span the whole file
+		var offset int64
+		for offset < size {
+			header := make([]byte, 16)
+			if n, _ := f.ReadAt(header, offset); n < 8 {
+				return truncated
+			}
+			box := int64(binary.BigEndian.Uint32(header))
+			switch box {
+			case 0:
+				box = size - offset
+			case 1:
+				box = int64(binary.BigEndian.Uint64(header[8:]))
+			}
+			if box < 8 {
+				return truncated
+			}
+			offset += box
+		}
+		if offset != size {
+			return truncated
+		}
+	case ".svg":
+		if !bytes.Contains(tail, []byte("</svg>")) {
+			return truncated
+		}
+	}
+	return nil
+}
+
+// verifyPaths returns cached files of a photo that are missing or broken
+func verifyPaths(paths []string) []error {
+	var errs []error
+	for _, path := range paths {
+		if err := verifyFile(path); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %v", path, err))
+		}
+	}
+	return errs
+}
+
+// VerifyCache checks every photo of targets is cached with all its variants
+// and placeholder decoding. It returns the number of healthy photos and what
+// is wrong with the others, which export processes again.
+func VerifyCache(author string, targets []Target) (int, []error) {
+	opts := GetOptions()
+	strategy := GetPlaceholder()
+
+	healthy := 0
+	var errs []error
+	for _, t := range targets {
+		prefix := slug.Make(t.Prefix)
+		m := GetManifest(prefix)
+		for _, photo := range GetPhotos(t.Path) {
+			fn := slug.Make(filepath.Base(photo))
+			if !m.IsSet(fn) {
+				if err := CheckDecode(photo); err != nil {
+					errs = append(errs, fmt.Errorf("%s: %v, it is skipped on export", photo, err))
+				} else {
+					errs = append(errs, fmt.Errorf("%s: not cached", photo))
+				}
+				continue
+			}
+			sha, crops, focus := GetSHA1(photo), GetCrops(prefix), getFocus(photo)
+			fit := GetFit(prefix)
+			opts := opts
+			opts.Alpha = photoAlpha(photo, opts.Alpha)
+			id := getPhotoID(sha, t.Sizes, fit, crops, focus, opts)
+			if m.GetString(fn+".id") != id {
+				errs = append(errs, fmt.Errorf("%s: outdated, the photo or settings changed", photo))
+				continue
+			}
+			name := GetFileName(filepath.Base(photo), author)
+			fallback := GetFallback(m, fn)
+			// formats published for the photo, those whose encoder failed are
+			// left out until it changes
+			formats := m.GetStringSlice(fn + ".formats")
+			var paths []string
+			width, height, _ := photoDimension(photo)
+			for _, r := range renditions(storeKey(sha, opts), id, prefix, name, t.Sizes, fit, crops, focus, width, height, opts) {
+				_, published := r.files(fallback, formats)
+				paths = append(paths, published...)
+			}
+			if placeholders[strategy] != "" {
+				paths = append(paths, GetPlaceholderPath(id, prefix, name, strategy))
+			}
+			broken := verifyPaths(paths)
+			for _, err := range broken {
+				errs = append(errs, fmt.Errorf("%s: %v", photo, err))
+			}
+			if len(broken) == 0 {
+				healthy++
+			}
+		}
+	}
+	return healthy, errs
+}
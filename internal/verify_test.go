@@ -0,0 +1,109 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func box(typ string, data []byte) []byte {
+	b := make([]byte, 4, 8+len(data))
+	binary.BigEndian.PutUint32(b, uint32(8+len(data)))
+	return append(append(b, typ...), data...)
+}
+
+// testFiles returns a complete file of each cached format
+func testFiles(t *testing.T) map[string][]byte {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, 16, 8))
+	var jpg, pngData bytes.Buffer
+	if err := jpeg.Encode(&jpg, img, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := png.Encode(&pngData, img); err != nil {
+		t.Fatal(err)
+	}
+	return map[string][]byte{
+		".jpg":  jpg.Bytes(),
+		".png":  pngData.Bytes(),
+		".webp": []byte("RIFF\x1a\x00\x00\x00WEBPVP8L\x0d\x00\x00\x00\x2f\x00\x00\x00\x10\x07\x10\x11\x11\x88\x88\xfe\x07\x00"),
+		".avif": append(box("ftyp", []byte("avif\x00\x00\x00\x00avifmif1")), box("mdat", bytes.Repeat([]byte{1}, 64))...),
+		".svg":  []byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 16 8"><rect width="16" height="8"/></svg>`),
+	}
+}
+
+func TestVerifyFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "moul-verify")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for ext, data := range testFiles(t) {
+		tests := []struct {
+			name string
+			data []byte
+			ok   bool
+		}{
+			{"complete", data, true},
+			{"truncated", data[:len(data)-3], false},
+			{"half", data[:len(data)/2], false},
+			{"empty", nil, false},
+		}
+		for _, tt := range tests {
+			t.Run(ext[1:]+"/"+tt.name, func(t *testing.T) {
+				path := filepath.Join(dir, "variant"+ext)
+				if err := ioutil.WriteFile(path, tt.data, 0644); err != nil {
+					t.Fatal(err)
+				}
+				if err := verifyFile(path); (err == nil) != tt.ok {
+					t.Errorf("verifyFile = %v, want ok %v", err, tt.ok)
+				}
+			})
+		}
+	}
+}
+
+func TestVerifyFileEdgeCases(t *testing.T) {
+	dir, err := ioutil.TempDir("", "moul-verify")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	files := testFiles(t)
+
+	tests := []struct {
+		name string
+		ext  string
+		data []byte
+		ok   bool
+	}{
+		{"jpeg padded after EOI", ".jpg", append(files[".jpg"], 0, 0, 0), true},
+		{"avif box to end of file", ".avif", append(box("ftyp", []byte("avif\x00\x00\x00\x00")), 0, 0, 0, 0, 'm', 'd', 'a', 't', 1, 2), true},
+		{"avif box past end of file", ".avif", append(box("ftyp", []byte("avif\x00\x00\x00\x00")), 0, 0, 1, 0, 'm', 'd', 'a', 't', 1, 2), false},
+		{"webp extra bytes", ".webp", append(files[".webp"], 0, 0), false},
+		{"png for a webp", ".webp", files[".png"], false},
+		{"jpeg for an avif", ".avif", files[".jpg"], false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, "variant"+tt.ext)
+			if err := ioutil.WriteFile(path, tt.data, 0644); err != nil {
+				t.Fatal(err)
+			}
+			if err := verifyFile(path); (err == nil) != tt.ok {
+				t.Errorf("verifyFile = %v, want ok %v", err, tt.ok)
+			}
+		})
+	}
+
+	if err := verifyFile(filepath.Join(dir, "missing.jpg")); err == nil || err.Error() != "missing" {
+		t.Errorf("verifyFile of a missing file = %v, want missing", err)
+	}
+}
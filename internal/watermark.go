@@ -0,0 +1,135 @@
+package internal
+
+import (
+	"image"
+	"sync"
+
+	"github.com/disintegration/imaging"
+	"github.com/fatih/color"
+	"github.com/fogleman/gg"
+	"github.com/golang/freetype/truetype"
+)
+
+// WatermarkOptions of `[watermark]`
+type WatermarkOptions struct {
+	Text     string
+	Image    string
+	ImageSHA string // a new logo at the same path re-renders variants
+	Color    string
+	Position string
+	Margin   float64 // relative to image width
+	Opacity  float64
+	Scale    float64 // watermark width relative to image width
+	Sizes    []int   // watermarked widths, all when empty
+}
+
+// anchor of each position, 0 is left or top and 1 is right or bottom
+var watermarkPositions = map[string][2]float64{
+	"top-left":     {0, 0},
+	"top":          {0.5, 0},
+	"top-right":    {1, 0},
+	"left":         {0, 0.5},
+	"center":       {0.5, 0.5},
+	"right":        {1, 0.5},
+	"bottom-left":  {0, 1},
+	"bottom":       {0.5, 1},
+	"bottom-right": {1, 1},
+}
+
+var watermarkWarned sync.Map
+
+// getWatermark reads `[watermark]`, an image takes precedence over text
+func getWatermark() WatermarkOptions {
+	config := GetConfig()
+	w := WatermarkOptions{
+		Text:     config.GetString("watermark.text"),
+		Image:    config.GetString("watermark.image"),
+		Color:    config.GetString("watermark.color"),
+		Position: config.GetString("watermark.position"),
+		Margin:   config.GetFloat64("watermark.margin"),
+		Opacity:  config.GetFloat64("watermark.opacity"),
+		Scale:    config.GetFloat64("watermark.scale"),
+		Sizes:    config.GetIntSlice("watermark.sizes"),
+	}
+	if _, ok := watermarkPositions[w.Position]; !ok {
+		if _, warned := watermarkWarned.LoadOrStore("position", true); !warned {
+			color.Yellow("Unknown watermark position `%s`, using `bottom-right`", w.Position)
+		}
+		w.Position = "bottom-right"
+	}
+	if w.Image != "" {
+		if _, err := imaging.Open(w.Image); err != nil {
+			if _, warned := watermarkWarned.LoadOrStore("image", true); !warned {
+				color.Yellow("Skipped watermark image: %v", err)
+			}
+			w.Image = ""
+		} else {
+			w.ImageSHA = GetSHA1(w.Image)
+		}
+	}
+
+	return w
+}
+
+// enabled reports whether `text` or `image` is set
+func (w WatermarkOptions) enabled() bool {
+	return w.Text != "" || w.Image != ""
+}
+
+// applies reports whether variants of given width are watermarked
+func (w WatermarkOptions) applies(size int) bool {
+	if !w.enabled() {
+		return false
+	}
+	if len(w.Sizes) == 0 {
+		return true
+	}
+	for _, s := range w.Sizes {
+		if s == size {
+			return true
+		}
+	}
+	return false
+}
+
+// drawWatermark draws the logo or the text over a resized image
+func drawWatermark(img image.Image, w WatermarkOptions) (image.Image, error) {
+	dc := gg.NewContextForImage(img)
+	width, height := float64(dc.Width()), float64(dc.Height())
+	margin := width * w.Margin
+	anchor := watermarkPositions[w.Position]
+	x := margin + anchor[0]*(width-2*margin)
+	y := margin + anchor[1]*(height-2*margin)
+
+	if w.Image != "" {
+		logo, err := imaging.Open(w.Image)
+		if err != nil {
+			return nil, err
+		}
+		mark := imaging.Resize(logo, int(width*w.Scale), 0, imaging.Lanczos)
+		for i := 3; i < len(mark.Pix); i += 4 {
+			mark.Pix[i] = uint8(float64(mark.Pix[i]) * w.Opacity)
+		}
+		dc.DrawImageAnchored(mark, int(x), int(y), anchor[0], anchor[1])
+		return dc.Image(), nil
+	}
+
+	font, err := loadFont("JetBrainsMono-Bold.ttf")
+	if err != nil {
+		return nil, err
+	}
+	// measured once at a reference size, text width scales linearly
+	dc.SetFontFace(truetype.NewFace(font, &truetype.Options{Size: 100}))
+	tw, _ := dc.MeasureString(w.Text)
+	if tw == 0 {
+		return img, nil
+	}
+	dc.SetFontFace(truetype.NewFace(font, &truetype.Options{Size: 100 * width * w.Scale / tw}))
+
+	r, g, b := parseHexColor(w.Color)
+	dc.SetRGBA(r, g, b, w.Opacity)
+	// the string anchor is its baseline, flipped to keep text inside the margin
+	dc.DrawStringAnchored(w.Text, x, y, anchor[0], 1-anchor[1])
+
+	return dc.Image(), nil
+}
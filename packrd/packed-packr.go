@@ -18,7 +18,7 @@ var _ = func() error {
 		"4b1a4974bf18cba3b8d3f5e472a8deca": "1f8b08000000000000ff8c90b1ce1b210cc7779ec2e51bbea5097ba43e40874a1dba5551e423bec3aac1087c4deeed2bee92bd13e267f3c73f7fc05d63bf4032abfd12c2c296d6e91c3587acab44dd8f608d2864ec462d8c071ff4c45c854ea36a9ac54dd809be810fde2d78cb847d6d94a9d88def837be77e77db84aece12e5bdb76fdd289f6aa3991a9548de45fd4b6dd42215a33640312a3690d06c23a6369d7904153c727ee82ade4dace3f22b11642e9c51b81bd47512ee89cb02a62a306b839ad474695813b5be0fa69151aece1e6c76fc3fc46e51bd3b36f246af8578c7a51b2e0df3bb721ecd33469a54ff1cc69baeb64ef4d67fa95c9db1c94e7f8e413a3cd81260d9007ba568d0d078c72220236d6984f6c53ba3e7be89ef053e77891ea28a5034d6f209b3ca9ddad7c3ef3f620b473afb7f010000ffffc405d34a03020000",
 		"b92ea33cb48c3cf2e715eb756fa395f5": "1f8b08000000000000ffecfd7b7853d7953f0e7fd6da47574bb264cbb22ddf64c992e5bb2dc9c610420421c425406842095097707180104228710821847a3209a594524a698632194a299361184a2993320ca58452ca249452ca504a5d9221946128cd304c4a69ca80fd3e679fad83ec24edfbfcfefe729ea57df63a6b7dd6da6bdff739714000f208d0101afdf027ee0740e0061700fbfda3ef1be37bd8d70e503d80c6fb273ef8f093a396fd1f409d00d6dcfff0a746a51676ff0740978107a63cf87053e261ed5d018c4f039831fbc9998b26fec7ee21c0f82d80fde6ec25dda1ea2fe46e07977503d83e67d1dc2737b7159c029797029e82b9339f5e04c00b2e5ba0db9fbbe0b939bb8f7e6a0830f955d0e74ece7b6c6697afeb540fe88cfebc6ddebcc766f67f573b0b3ab30d40d5bc27bb977ef08b7a07e8cc9b40ee8e054fcd9ef9dbb7bfd00ebad40dd8de7f72e6d2458e27f33680aece03105a38f3c9c7ee7deed367415757019697173df574f7f7971d7f0b74dd0bd47c7ad1e2c716adfee9abe5e0fa14803332567a64f47f0c0b87f9336031477c0f2cbe2f7e08217e267e06217e2d7e0d21230938310af320468f193709fed9cf2d5e80d08299dd0b510f4d87e9ef975277ee29eb9e219e786cf142f8e56f50fe86146ef6afaec3b08324e54904820b24be26ef88a3ca970e7162802f61e94bb369930188ac9c8e7c27c7b0c03a7be6826ec4f45f4aebbf3c6dd9638b9f12ddf27799fced01f045a32549340bacb0c10e079cc8810b6e78900b2f7cc8433efc2840008528423182284129ca508e0a8450893022a842143154238e1ad4a20ef56840239ad08c162490440aad68c310b4632886e12e0cc7dd18817b90c6488cc2bd188dfb3006f7a3039fc0583c8071188f09781013f1493c848731099fc2643c8229988a69f8343af1194cc7a39881999885d9e8c2639883b99887c7311f4f60019ec4423c8545f82c16e36974e3192cc1b3588ae7b00ccf63395ec00a7c0e3df82bbc88bfc64b78192bf179acc217b0faff45e6ff45e6ff436448c546fd92603b07b88a533c86a7f01ceee6d5bc915fe3bd7c94cff065fe40b84448348b116282e8140bc472b1466c16bbc411715a5c12d735d6025a4c6bd3c66853b4f9da326db5b649dba91dd6ce6a97b59b16bba5dcd26c495b1eb2ccb22cb6bc685967d962d96d396a3963b962b969755a8bac316b9b75b47592b5cbda6d7dc9fa8a75bb75aff5a8f58cf592f586cd6e2bb2d5dada6d1db6c9b62edb62db8bb6f5b62db65db603b6e3b673b6f76c7d76af3d646fb6a7ed13edb3ec8bed2fda37d8b7d9f7da8fdacfd82fd96f38ac8e8023ee68777438263bba1c8b1d3d8e758ecd8e9d8e038e638eb38ecb8e1b4ecde977869dcdce11ce71ce69ce79cea5ce95ce0dceadce3dce43ce379d279d679de79d979dd79c1fe420c79ee3cd29ca09e5c4739a73da73d2391d391373a6e4ccc89997b32867694e4fceaa9c75391b73b6e46ccfd99db32fe750ce9b392773cee69ccfb99c732de703175c7697d755e40ab9e2ae6657bb2bedea704d744d71cd70cd732d722d75f5b856b9d6b936bab6b8b6bb76bbf6b90eb9de749d749d759d775d765d737de086dbeef6ba8bdc2177dcddec6e77a7dd1dee89ee29ee19ee79ee45eea5ee1ef72af73af746f716f776f76ef73ef721f79bee93eeb3eef3eecbee6bee0f3cf0d83d5e4f9127e4897b9a3ded9eb4a7c333d133c533c333cfb3c8b314567dee702d75ad726d74ed50b95dae83ae13aef33267715d74dd70dbdda5ee6699b7ba53ee0e77a77bb17b957bb3e26c75ef751f739f77dff0b80c048fd713f2347b46a9dc18cf64cf1c65cbee59ee59e379d5b3d373c073dc73ce7355c95cf1dccc75e6961ab9dc506e736e3af721c383dcc9b9737297e4aeccdda8f29b7277e61ecc3d957b49e5afe4def43abd416fbde18fb7d19bf63ee4edf22ef5ae3124bc6bbd5bbc7bbc47bdbd326ff3bee3bdeaedf3797ce5be465fdae0f946f826f8a6fb16fa56f8d6fab6187abecdbeddbec3be33be2b865fbeabbebe3c4f5ec8789a5795d796d791d799b7c8789ad79df752de86bcd7d4d31d79fbf38ee5f5e6bda7f257f3919f971fcb1f66e4f347e44fcc9f95bf247fb5e175fedafcadf97bf38fe75fc8ff4049dcf27bfc217fcadf6144ce3fcedfe95fe05fe15febdfecdfe53f6448f90ff84ff8cffbaf17d80d9c025741a8205530b66046c112c3af826505ab0b3615ec54b9dd05870a4e165c304a5d70a9e046c01a08046281b68061c71218139812981f581158aff2af04b607f6074e042ea8fca5c00785cec2f2c28461b1b0ad706ce1f4c2eec2d5855b14675be1dec2370b7b0baf16b1a153a415f98b62456d45ca46d1d8a26945f38b9615a91a2a5a5bb4a5684fd1d122a386ac45ef145d2be6627f71bc78b851fae211c5138aa7172f2c5e51bcb67873f16ec5dd597ca0f878f1b9e2f78a6f055dc172032d180cd60747041f0ace31d082f383cb82ab839b823b82078c28040f048f07cf058dd6670fbe5fc2257925e19244c9a892874aba0cad9219258b4a7a4ad6956c29d963e096ec29395272a6e472c92d43a214a579a555a56da5634ba71b12a5334a1797be54bab174a7caef2e3d5c7abaf472a9a161294399b72c54d65ca6fa46d9e8b249655d654bd4d365656bca3697ed293baaf2c7cace955d2de7f280e16779b0bcb67c58f9d8f269e5f3cb9795abd895af2adf58bebd7c5ff931a34ecb8f97bf537ead822bfc15f10a237a968ae115132a66547457ac52f93515af56ecac385071c2f0a3e264c5f98a6b21a3b6b490351408c542ed866c6878685ca833b420b4423ded09ad0b6d09a99884f686de0cf586ae56aa9aaeb456062a63956d95aaa62bc75676562eac7cb1f21595df54b9b3f260e5a94aa3f75a2baf54f685f3c2f1f088f02483139e129e1f5e1e5e1bde1ade6b580cef0bbf193e1b367aa1257c35c2117f241e51658ba42393227322cb226b557e7d646b644fe470e4b4ca9f895c8adca8b2561519f855c1aadaaa615563aba6552d3024aa1654ada85a5bb5b96ab792d85375b4ea6cd595aabe689ee141d41fad8aa6a2630cf9e8d8e8b4e8fce8b2e81af5746d74737457f490ca1d899e8e5e8cde50b99b31672c1833c6264bac39968e4d8ccd882d56f9eed8cad8c6d8ce98d28d1d899d8e5d8c29ddd8cd6a6775b0dad0d5aa9babd3d513ab67199ad573aa9756afaede5cadeaa17a6ff59bd5bdd557e3aa1ee2d67851bc363e226e8ca2d6f8e4f8fcf88af8faf8f6f841c5391c3f1dbf14ffa0c655a346b49a704daa664ccdb49a852abfb8e6a59a576a76d41c54f9c335a76b2ed5dcacf518f9dabcdaaadab6dab1b546ebb7d6ceaa5d5cfb62edfadaadb5af2b89d76b8fd69ead7daf0e46be4eabf3d755d5a5ea8c485aeb3aeaa6d4cdab5b5ab7aa6e9392d854b7b3ee60dda93ad53eeaaed4ddac77d607eb6beb557dd70faf9f503fa3bebb5eb5e5fa35f59beb77d71fa93fabf2e7eaaf36a0c1df1037f20df50d231a263674352c55f9e50d6b1bb634bcdef0a6ca1f6f78a7e15aa3d6a8da476369636363baf1a1c6398dcb0c89c6e58d6b1a5f6ddcd9a8e2d078a8f154e3c5c60f9ad47cd7e46d0a353537a93edd34a66972d39c2635b7362d6f5ad3f46ad32e95dbd374b8e954d34503a7e972d3cd665773a839653c6d6e6fee689ed23c5fe51636af685edbace6a2e66dcd7b9bdf6c3ed77c4d3dbddea2b5f85b6246aea5b66558cbd8964e43b66546cbe296975a36b6a831bf6577cba196932d1754ee52cb8d84356194d692284d3426d289498979c6d3c482c4f2c49ac46695db9ad893389c38ad72671397131f249d462ee94996271b9369951b9d9c94ec4aaa3927b92cb93ab929a946c1e4eee4a1e4c9e4f9e4fbeae9f59496f2a794efa9dad4b0d4d854a7cacd482d4af5a4d4dc937a25b53db53f7522a57c4f5d4add68b5b61619bbd6d6529506555afe31fc8c7c60905c58a53195c607e5abfec2f35a95d6ff857cfc2ff007e335aab459a589bf906f57699b4a5383e432fce12a1df131f9b44a47ab748c4a3b543a76507ecc20f9ccf3712a9d30283f49a553543a79507e9a4a3b553a7d507eda5fd09ffc31f283f166a974be4a17a874b14a97a8b4fb63f28b07e597ab74c5a0fc4b2a5da5d2352a5d3d28bf4ea5eb3f26bf41a51b55ba49a5afaa748b4a37ab74ab4ab7fd85fc7695ee52e9eb2addabd2fd2a3da2d237557a5ca5a7547a46a5e7547a5ea597547a45a5b78cb40d10a0365664976746faf3f7c1ad1fb47e20f3d47a15d47a19c609d51a2571d2b4b646ca9c3429837108dc7aa8f590c2d8016add3d0863b319a92f49993592b8754deb1a13651944eb32fd921c6e9da5b799d6790a69add49b60b468d5aabfac34d3e0d6b4ea495f9672f592446bbd7e99168aa0b5161997e489549f7e815a3565659d6e397539751994ba6a1080afe898a953a0d45970ea6ceaacc905287518227558bfb264f74812a97da97da98319fba9cdd052db52db523b523b94fd15fa054abda2ec7f45da9f979a074a2d03a57ab2301f02a5bac0a9aed4fc01f6a741a426a426a42665c9b62b1a6bda8e8353f1d430a38e525650aa36cb2614af280b030625af673092d7c0c98bc98b0646f23028795a61ac5712270cbee250f280a2374d8c9de0e4bee43e33ff2a38b93db9cbccaf01273726b799f9e5e0e4aae42633bf009c5c9a5ca77c18014ace1be4c358507272960f3145663b4806c0c9d264a98191b8094aba14c6570d89c47583af3894b8ace86a062371169c7827f18ec2d80b4a9c1884a1f30e48ce062973daa40cc61170e248e288997f1d9c783db15f61ce0225b629cc0d4a62bd4100be26657a2471a227d163a22c84482cd42fa31f2582892028d1a9905e917a5650a2c320007fa33453e0442a9132799408298a299f02a08457a6196b0027ac09abf1bc6517a8e586b2a4505bde01b55cbc83d9725c12b71c6f39a5b40e815af6835bf6b71ccae0b66c8768d9deb2abc56819dc32a96512a8e55585bd51eaa5412d2f825a56993c5d7321b865614b7796dc3449dc32a3658689df01d13241bf0cfce6ebcdd7412dc315fed775bde6f3a096b8410036294d0fb8c5dfe23779d47c5391d13aa8f91d50f315992a6bcdbde0e613cd27ccfc3170f3c1e663667e3fb87957f351a53f0fd4bc5979a2ac366f0435afc9b2b94cd14aa5b304d43c5fa6467e31a8791aa8798e69632cb87972f364333f0cdc3ca6598d084dd741cd6dcae6df2a8922507395c9a166ab246eb6365b33284dd7219aaeeb9711c7a69d4d3b414d1714d2ab127b23a8e9844100fe4e69ee0737ed6fda6ff2a8698b4919f475e0a6754daab7374d0435f528e40cca4283006c96329d92b8a9b3a9d344190bd13456bf948ff6263ba8a95d217d43d76bbc0e6aaa3208c016a5e90537799bbc268f9a828a4226ba53c9481f1bf5d267def12894c6cba0c6ab77301acf98a4301a8f821b8f361e35f37bc18d7b1bd72bcc29a0c6d714e63795c40683006c95322f4ae2c6171b5f34511641342ed22fa3dc8dde462fa871ba42fa96aed7700bd438d62000db94661bb8b1adb1cde45163581237861bfa4c7c2f44a3b7e1bd86f70cfc86dd0dbb410dbd0a5fea35bc0a6ab8026a389a8ddf7006dc70a6614f96dc6649a2e144c3e90673846c580dad617fc321fd3266cc8671fa056ad89965851bda1ada400daf1804e0ef256608d4b00cdcd0d3d0637275d414444357c3fc86f959b2e592b8a1bc619269dd05d1e06a18d530ca285ffdc9fa93a0866665f9355daffe00a8fe12a8a1d4e401547f0a5c7faac19e25775091399bd6ef02d75facbf68e63743d49faeefad3f6f72d680eb0fd79b2ba4fae5e0fa3df55d667e01b87e6bbd3142527d0254bf5ef9f60f4a622ca8bec7e4507dbb24ae5f58bfd0448941d477ea9751caba2b7557a4a681b45dd7ab3b03aa6f3308c03f1a9a75ef81ebc3f561934775672589bad7eb3df59e8c85ba23d0eab6d4ddd42fa316eb56ea17a8ee92b2b2435a5e54b7085477d22000ff2431a781ea6680ebf6d7ed37b93aea4288bab175dbeab665c94e5164ae17ea3ac075abeb561b31aaf380ea96299b199c18a86e5e16865f91397ed469e0ba7175e30c8cda63a0bae103316a2f80ea6aef60d49e34c9d0b901aa3d24c9c89f00d5ee96a46cd46e01d76ea93547beda75e0da75b5aa6e6b27836a3323df4e25b1d02000df96329d92b8b6b3d6f4bc762c44ed58fd32eab6d653eb01d56646be5dba5ecd4d506d954100bea334bde05a6fadd7e451cd2d495c1bac1d91c1afb902516bafadaf35d6565cb3ab6617a836a8f00dbd4da09a0ba05a6b367ecd6e70cd899af7b3e45e95c435a76acc79b3663544cda19ae335c715fe849a09a09afd0a7fb7d41ba66cbc66f274cd89e09a9535ebb3e4864b123555fa655a8843abf11a97d136e357f40b14bf956585e3a7e3a741f14b0601f8ae8e193f088a9f04c74fc64f9a5c80e2a720e2fbf52b4bf680248e6f8b9babdbf80e88f87afd32391b21e23df19e78af51e27867bc13145fa87cd923913a40f1690601f867a5391d1c1f131f63f2283e5651c2441f06110feb97c98983e3def87ca39d555f05c533b3d7eb4ac26af01587e2b506559f533a1741f120a8fab889e904571fa83ea09e6f02556746ebef1912d5970dbee250f519495c7da1fa4206a5fa0844f549fd322251fd50f543a0ea835948543d0254bdc320007b95e66a70f52bd5af983caa4e81aa9780ab9754bfa8bcea92c4d55dd58b4c8b0f41543f543dbd7aba6131763e761e543d4e59fc175d2f761c545d0faa6e3779ba6611b8baa8ba2a4bee30a85a0355ab7541ecba41d550f96ba0d845991af995a0d86950cc1cfd6347c0b193313582c45e02c55e07c5d408121b0d8a6576d9fb94c67a506cb3c9a1d81a933298cbc1b1e5b1e5667e0138b620d661e63bc1b1ced84465230e8a8d9364e4f340b1e192643e7a0814ab573efcab42081a0460bfd4b14be2983d669e39446f40446fe89711e7e8d2e85250f4a242fabec4ee02454f1a04e080d23c008e1e881e307914ddaee825137d2338da1ded36f3abc0d1aee82a33bf141c9d146d33ca50750b141dad2c67ac4c0645135936462b3277d3d114383a2caad6ce55fb40d1da411879a0685116060caadaa9745e07555d0355bdaaf23b4055e741556b547e0ba8ea24a86ab9cabf02aa3a04aa5a90f1a16a37b8aab3aa533d4f81aac60df4a16a2da86ad800ce3070d594aab0d2a95564d67f55105cd55ed56ee69de0aa7895d3908fe8fe14291b3f302422974155d6019c3310913391eb91eb4aeb88248e1c895c509c5512cbc03928394b40912da0c84193a723ad0147d6447664c92d95c4915722af647c8ccc8188bca45f26671244647164b192e14851a4081499a12cbe21913450649c41000e29cd2038d21e69377914b14ae2f0d58819a5f07588f0b9485bc468431cde17de078a54297ca917de060aef0545bcd9f8e1fde0f0d6f0ad2cb9259238bc36bcc4c4ef82082f0f7785bb14fe94f01450f8a101f8a341e1c9a0f08801f8b5e0f0a8b0b112f961163e292c0a4f0285e7c834636d32383c3a3cda785ef91e289c52967ea82452a070380b336c5206230f1cce0be7298ceda0302b8cc38644e5358300fc48cabc23892b8f579af346e57188cafdfa6594bb7246e50c89862cbd71a0ca0d060138a234d7832b7b2a7b4c1e552e90c4952b2acd1e53390da27241e5b4ca690a1f953ab743e1ff58d70bbd07aa1c03aa4c993c5d2604ae4c548624efa894bb00aa74812a9b8d32876e1954195231f02a2c35fe875e0385ce812a91f126741c1cba16324b1f3a000e9d0f9d57f25da050a68f1c55129b40a183593eac322983b1141c5a1a5a6ae6e78143f3426a4c09e93e4d5198ffa624c61804e04d299392c4a15428a5b43450280c0e8543667d87bc1021af7e6538157d10157d157d4a862bb6556c0355bca7acbda52355ac03559c3508c031a579045c71a4e288c9a38add92b8a2b7c25c1f576c86a878b36273c566853fba6234a8628dc2ff89d46b06556c05552c3579ba6617b8626d4597e41d9772134115cb642a4b58315f51dab4360d5cd15ca1eab65cf739f30de74f95443ba8c26372a822a6c83c17a808802bbc15aafecb37802a3010a3fc3aa8fcea1d8cf20b8accdd4bf92970f9ea72b57b299f082a5f36086337a87c5e16c6669332186bc1e56bcbd79af915e0f215e546ffa7b25ba0f2cceaf28492e83408c0cf4cbb066530d2e0f274795a61ec03956776c53f5312e50601382931ac92b8dc5a6e9e1d955d8728bbae5f469d962d2a5b042acb9c1d49bdb24e50d9098300fc5c69ee0797ed2fdb6ff2a8ec3545665f287b055cb6b4ccec0b652bc165f3ca7a94cf1aa82cd31732a85da0b23159980f49e2b254d93813250d51162e1b5666ccfd5c7aa0f400a82c9e8544a5db41a5b74065c619e129a56905975e2e83c9a3d2f72571e9fba5e6f978e97988d2f3a57b4bf72afcced24e5069e61cd8d0eb0095ee0795aecbc62f7d0d5cfa5ae9f22cb98424519a284d949a6bc0d27268a5e5a5e5a54b4b8dd888920ff40b54da9565854b2e945c00955c07954eb88359721c54f20eb8e49dd2f66ceb256f4294bc59f26669384b768f242ed953ea32a25eb20f54b2055cb2a5e466c69f92b510256b4b2e975c364a5c32bf643ea8e48cf2e5dfa5de6450c93c50c96193a76b4e02974c2ad99525975664f6a0926670c9ea12d5834a3ca0924c0f3aad243c06b6e250f0962411bc5532b6c45cf1052f43042f072f97a8393f782478045452958545c1dda0e0615089371b3fb80b1cdc15bc9525b74992086e0a9e0c9a6fd1822b21822b832b8307948559c159a0e08e01162680823340c157065818070e8e0bbe9825d7ae68a5891e07079704cd3546b0081c9c135c6cc4a5f80628385959fa85212179a34d0e155f342818cc60149f06079dc146337f045c7cb3f8a6c2dc0a2abe320853e79dcdc25c675206a3075cdc53ac7a69f17250f1224999e733c0c5338ae7a8e72340c513958d334a628441007e2965da41c5cbc0c5cb8ad55979f13c9332a853c0c5538aa718cf8b2e838a33ab825f2a893683009c95321740c55510c555c555c56a9e28f64ae2626fb1392f16f54114f5e99751ab456b8ad6808a32f3e2af24d65250d1598300f42acd23e0a22345474c1e156d332983be015cb4a16883f2bb1954f49242cea02c3608c0afa5cc0c452b4c8c89e0a28545e67960511a5c34bd48cdfe85e740451314a6fa2f248aca4145c3b3305d8accb54de12d70e1b542b5b72ddc082a3c3f10a3b0175478e20e46e19b8a0e9b18fbc0857b0af7288c49a0c2ad83305e0115aecbc2d86552066333b87073e16685e10515ae1d84b1dc2000e7a4cc7c452913631ab8b0aad03c312a1c0b2ef4171a7b240a1c001566de1e9f53127150e0fd2ccc80242e0c149aefcd0a3588424dbf8c7611e80e744b2d03e96d893d031478c72000ef189a8163e0c0b1c031934781958acc77808125e0c0a2c022e56391c4c2009449a0c0842c8c518acc7d4120010e8c0ea87d41c16950203508c30b0a84ef6014ec304961146c02176c2ad8a430d6810a560fc428586a1080ff9032af4be2825d05af9b285b210a5e2dd85ab0d58855414741874443965e0a54b00454b0dce4e99af3c005b30a8cd1fdbc946b93c4050b0ada4cfc2a888269055505461db3ffb2ff32a8204fe14b3dff69c386bfcfe401e4bf022e08f98d6f2dde95726724b1ffbadf7cbbe33f02e1bfe03fe23fa2f057f95781fc7b14fe05a9d70df21f00f9379b3c5d7335d8bfdd6f7cbbf21b29b74492f06ff06ff09b6fd1fdb3a0f97bfc3dfe6dfe6dca4695bf0ae4cfc4e83792e7f57b41fe2920fff23b88f93741fe5160ff28ff1c93ab637a20fcf5fe7affc42cd9f740fe00d81ff0abb30fbf2689fd9a3f9ef126ff7d88fcf7fd01bfd1da397f63fe462965f8725122bd04ca3f0ecabf66f274cd7de0fc7df9e7b2e4b649e2fc37f3cd3552fe7a88fcbdfaa5f0dbf3dba59481ff9f52af0a94bfce20009794e61470fef2fce5268ff2e749e2fcd1f9e66c903f19223f913f39df7857ca7967f3ce82f233a744ffa5ebe51d01e5bb40f9cd264fd72c05e77d906fbc0bba2cf1ed9238df9e67bee9c9bb01917723ef54de2985bf346f2928ef90c2977a795da0bc93a0bc9d264fd73c00ce3b90b7314b6ebb24cedb9ef7aa89ff0a44de2b79abf3562bfca2bc2250ded201f81a286f9161270bbf139cd79937314bae43d17013bd1d9c579f677e4d911707e795e699b59f5704ce73f98cd64fbe5741becc49fa6f0d09df0d90ef8ac921df4549ec3beb3363e43b05e13bea3be55331f24df24d02f93231ba22f5d220df16906fa7c9d33537827d6b7c468c7e27e546293223e44b4819734dec0b837dcb7dea8cc47b19e45ba02cfd4e49e8342d0bb3c32443e71d90af5d5206330ef6c57d665c7c45605f914f9dff7a5f02f9eccac67b8684f7864100fe5bcaac96c4de8b5ef394c0bb14c27bcabbdfabce48bcc3bdc341decc1989a11707797782bc1b4c9eae3902ecdde8edc9929ba1a856f914047927cad4c88f0379d320afd3b4de0ccebd99ab5678b98740b99915de5525e101e59e3539947b4b12e71ecd35df6ee75e81c87d5dbf8c32e42ecd5d0acacdcceeff23f5ba40b96b0d02704d69ee06e72ecb5d66f228f725499c3b27d7fcd221773144ee24fd52f845b945a0dc510aff7fa59e06ca6d3408c0fb4ab31d9c1bcc0d9a3ccab54ae2dce11ef3db1fcf7588dc5acf398ff106843dfb3cfb409e630a5fea79b619363c7bb3f13dfbc19e6b9ead5972af4962cf118fb943f76c80f0ecf12cf72c57f8533c53409ef90afff7526f34c8b306e4996cf274cd6960cf52cfa82cb94649ece9f2983b634f2984e721cf128fb11b60f707ee0f409eae6c7cf74590a71ee499988defbe00f614798cb5df75297742127b4a3d664b771f80f0383d018f1affdd1bdd1b419eccf86fe8bd64d8705f3379bae68b60f749f7b92cb99592d87dde6dbe19717743b84fe897c99905e13ee03ee056b39f3be68e81dc3b0658cc03b95f3108c01f94661cec7ed1fda2c923f75845e68ad83d0cec9eee9e6ee66bc1ee096ef506c1b51fe41ea12cdd50127690bbdee4903b2889dd41379b287608b7dd75cd65ac92d9b5d8b518e47a270b895cd341ae0b2097b1dafba3a1e93a01769d70ed3579d207495b33e8ae1d60d77a97fa82c41500b95e54c819945520d7a22c8c258a1e3231e6805da35cc6d70f94730ae44a0cc2180d7285b2301206e5dcf1230cce599fa3fcc8590bca19ec074039597ee45c57b427839173119cb3556152ce5850cefa8118398741393d5918132571ceac1cf3cd6ace08889c89393d39c62a99733887413999b3af0f743de755504e2d28679ac9d33535704e20674c96dc358372ccda749e073bdf77be6ff8e87c0de4cc9c63fd49491c04394f9a1c237f50dacc60ec023bdf71aa6f0a9db340ce138330d6809cc6dbaf9b52669e24762e749a6b6ee7640867a773a373a3514e67d0190439572a2443cf0a72b6819c8b4d9eae590a76869dd3b3e4ec8acc6f391c1f801d7d0e63374b8e8320c75585fc7f4ae20cc871cee490e398414ef3dccfb11fecb8e1b8a13096811c9706616c04394e4bce2d29d323891d6b1ce6fed0b110c2b1ccb1db619c11b323e148801c99efe60cbd72906312c8b1dae4e99a29b023ed589225175264ae6a1c5eb0a3d4a1be11b5eb65ca7c237adb90b05f05d96f9a1cb25f36c8519ec1b09f053b3c0e8fc2580fb2f70dc2d80bb2bf27397d5266a324b6efb6f79a282b21ec9bed6fda8df52fdbc7dac74a4d64e9b581ec4b41f6ad264fd71c07b677d9d766c9b52b324f72ed71b03d6d5727b936bd4c9993dc7e256105d9cb4d0ed95d8accfd93ed16d81eb3ab6f446d3b40f6c0400c5b2fc8aee91c3264d628327ba76d39d8f6a24dbd9db7cd01d9161b1894919806b2cdc8c2e850647ed3696b07db26dad4a9be2d0cb2a507610440b6c62c0c4d9139f75aaf836d2b6cc6e90859df01d9160ec4b09e02d93aef60580f196433fb887537d8da67557dc4ba0964bd3a08632dc87a2e0b63b922f39ccbba006c9d6135cb66ed045b275a2f29cc3120eba0b2598781ac5965b3c615996ffbad4560eb02eb0285c112750086e506c83af60e86e5a24156b3bd584e83ad69ab6a2f9683206bf3208c3d206b7916c61645e6971d967560cb298bfafad7b20c64393c086321c8b23b0ba353125b265a2e992863212c232ca72dc6d7826c495812594824f5ca419622906597c9d335bd608b66d99425e73148bb9541d7fac0da7b9af16523696740da3985cc4ae21c483ba63864e980136ce980e007c51c7109a4bd46e741da168c05699be4fd063c00b634c2222e8905e21237884b604b3d84b8c48d5c0fb6d4eaf7e249ae035be2609e2a9136d23b206d3d1e065b826071892783f552884b3c0da4ade8ff0dd86287e04f894b12d32a9f7580b4891809d64b232e89a5206d182d0169297280b56bd2724ada4863887cfa9efe14ed60ed9cf4650327c15aafbc97d8da59b0d82eef4e80c55aa9eda7cf81b583d28a9ed7e84b606d2f2ce2ab52ef359d2bf6513348eca1a7406227e9b85b25ee3689b6059ab824be222e89af83b5cd192c315f9660adcab3f692bcfb1bb0f6a2f4bf9aa360adc77cbe4496bd0aac2d90773190e8213f482ca34520d14d85606d0a34f179b152ac923a93c1628bbc1b0716ff28edc6a911ac8d86853bc525b149a18f3222c1699028421aac0d9356741f9b4d1f62f26e3958ab92773d203e4b0e109fa2c5203e4e4e101fa5c560cd29259e07f15e99673d0e7c170fe7bb7549e8f837a58d1160710dcc43741be2aa2cfd50d69fbf27dbd4256e179f95cfae80c5d3f2ee3234f194582c96c8dc2508315f74ebe5e33c1482c53b600ecbfc443a03e2b1c8078b93601e2d354ec0c2a3f85e7189ef1373c1e238583c2ee547406f8b4774cbdccc9fe156297f58b594bf068b43ea7e3d880ed05360be2acbfaf760de2e9f7d4bea40723f07a2b39403e66b9938b2f4597c012c5640882f8a3592bb012c5e9277abe5f397c1629eb2f54f20da4143c0623284d8a970642d701c44a9fe3d60f6675a1755e916454ab63dfd5a071609997b465c12df048b66997b427c5bfada98f14dc87e2b5ee75ab0a88555ec16df137b24c677c1222e9fbe2a254b21c4df8a67e57d5059fa86cc154188cd6299bc0fa827afc89c1f427c8def97f779eac97764ce0b2176f11479ef8193c7f1789e206bff133c961fe087f921b070c9d631494a39c1dca4971624c6cbbf2de497f792c356f9eb929c47e47daefcf5c85fb7fc7d56fe2e92bf16f93b5bfedae56fb7fe4b37e53dc97bf95791d82befcfcafb25f2fe96fcbd2d6d4d90fce5f27785fc75caa7bf0441b095fdd2570b5bd9cd1ece95be09f6f0b3eacea578ccf64c2a3d116c5177acdfa954f75dd04d26f3ce2b4b25a85fddb14a2d748badece525c673ee56779ac153b6325e787989e278651935bac5b9ba8ee4751b88ec37500c1dead7f3fa73ea577e2a79ba25f33a46c62ad32d192f41b7d888a245de759b768d54f72ec3d3bdeace78a552a3362c74d3d096d167955a6434fc2a27d8aefc93382a0a5ee5a3d0fd92b566587c5659bf533e234ecb337192b2c2e0816035907839af90f52b78b619dfd9bcc88cfa22936bc9e25ab2b876939bb973d22dea67622bdbd9c92ef698e5b0b253b679662be742e3297c0016d879354f01f08091d2747c82d200e7d81d426fe75a086c038a48fdfd308c1d150a610bd0d72f8ef5bd0f6877936706e86fe41f178bb1be660fc122ffd298b18ef0ebab07996af64b90dd0336f9d409379a30142be873f4655a4fdfa4ddf45ff43bfa03f573090fe1a9bc9f0ff0bff15bfc36ff9eff281ca24444444cb48976d12d36887f1407c421cda505b588d6a425b5566d9876aff6a0d6a93daa7d45fb8ef6dbf297cb3797ff29e40df943c15079281caa0ac542cda164685868746851e8b9d0b2d017423b43bb423fa8ccaf0c54862b1b2bbf1e4658841d6157382f5c100e86cbc39170341c0fd785878487873f119e197e2cfcf94877a42fcad19c68693412ad8ad645eba3c9682a7a577478f4c1e882e8e7a23dd155d10dd16f44b744f744f7460f440f458f468f457f56fddbba29e7717eebf97feeebefef97e1d46310423386618b8cc116fa0e5da22b749d6e71918cc1bff2013eca6ff1d9ac1844b362f07d714823cda39568555ab396d2dab4bbb4d1da27b5e9da3a6d9776b9bca77c73f9969037941f2a0a9586422a0689d0501983eed0b2504fe89f540c0a640cd68511e6b03d9c13ce0d17848b550caa550c3ac233c25de11723dd91ff8b222aa2ae6859b42a1a8dd6471ba2a9686b7478f4eee8c4e893d19ee85f45bf10fdda87627059c660cbf9dd320624febdff0f00fd831e8cbe9fe33406fcebdbdbb7027db84d84ff05f0592cc41e7c139bf075acc61730aaefeebe9abea2be025df6f66ce0f622e0f6d3b7bb07a2bcfb43e082e5dd7e95fbcdbb672f4cfd4de4ddff7c77cfbbcbdf5d7e21f0eeac77fff5ddfdefeebc703f70a1e54213707e5346f7fc0ae0fcccf38bcf7ff6fcd4f38f9c9f71fea1cc93ffd0773478fbf7c0db5781b7ff1b78fb3de0eddf016f5f01defe29f07615f076f8ed896fdff3f6f0b787bc9d7a1be76e9fbbd1bbe4ac3df24ee49e4842cc44a65fdd02a888b6c8a3f169a2534c1733c42cd125e667974374e163ff8949baace801c406995f2fb68b83e290382c8e88a38038a173b5e55a8ff6d2c7a300da02f93b1fd09669cbb5155a0f3058435b6a3c03b495da1a405b0b683b016d07a06d07b4d7006d1ba0f502dad93f67cd709dfa39c4569ecdabc404ae6427e7e8231efd1f7be926dbf5d19f9f9523a63e776bf2afa915a244feadb43a3462a8fc4b6823310af76392fcfb66d330135df2af962dc1b358ce1e76702eaf60bf3882bd388c1fe12dfc1467f12bbc8377f11efe07ff8b1bf8236e93935ce4262f1552903ae933348b66d33c768947d8cdcb21183c93e7f22c9e2fa68889e2b0f8243dc9a5fc02fbc443e2b898c4cbe82afd8ff8317f5e3cc842fc94fe9b1672197d407fa43f31c3010b6c70c30e173cf0238c52f9d7df5ad12cffbe5b11c6601c3a30160fe1015e8c89781c8fc9bfc7b6149fa2027c1fff827fc51bd88f833884a3388d13f8394ee1bff01bfc272ee1186e11a38f4076227e9aac5441255446e5d4453934074e1c8015fb90831f200f4750807f43006f221f3f46317e82208e23825fa00c3f4325fe1d553883729c440dcea11abda8c5dbe8a1081a701ef5f80fb4e37768c36fd1828b18822b48e1029a7019c370157f4555b886bbf13e5ea42846e0f7b807d791c61f702f3ec05f530ca371137fc27df83f3c48167c02fd98401a1e2607a690072f5135a6522e3e4d3e4c273f3e43f9e8a43cbc4c71cca662cca2223c459fc6020a630e9562214dc313548927692a9ea647f11c3d866578811ec7e7e809aca0f914c07812984f21c4f16baca41a7c9e6a918b1fe29364c32aaac317a85ebc257e248e8913e227e2283d4b7f474fd3d76803fd0d6da4afd37afa2a6da217e8f3b4969ea245f4595a4ccfd0125a4acfd1327a9e96d32afa027d9156d31afa12ada3afd097e915fa5b7a955ea37fa07fa5fd7480be4f3fa083f4433a4c87e8dfe838fd94b6d309da4647e9c7f426bd45c7e84774847e42bb681fbd41ddf4f7b4997e46dfa09378866662314d4737cdc06a6ac017a9116ba8095fa266aca5167c99125847497c8552584fadf82ab561030dc1d7a81dafd050fc0d0dc346ba0b5fa7e1d84477e36f69045ea57bf07794c6661a896fd0286ca17bf14d1a8dad741fbe4563b08deec7df53075ea34fe01f682cb6d303f8471a871d341eff4413b0931ec4b7692276d127f11d7a08bbe9617c9726610f7d0acfd35cfc334dc6ebf408be475338c8251ce0222ee64231557c9a353a233af99bfcb7fc77fc0dfe166fe3adfc2a6fe62dbc929fe42778217f99bfc27fcdebf925fe2c6fe097f979dec8cff0027e8e97f26afe223fc55fe735fc25315d3c2a668ad9fc73fe039fe237b95f40101f132c84d0f82d61115661e39f08bb700827ff8c4ff24ff9041fe78b7c96dfe3ffe6f37c867fc1bfe2dff115fe0f3ec7bfe65e7e9bdfe1dff0bff369fe255fe6dff205bec4ffc5eff2fff055fe4ffe367f8f5fe77fe63dfc5ddecddfe1bd7c987fc447d8f8ee661a407d7c12025638d09c6eb05958308d0369c48278aebe32128f40083caacb3fa8690ebb66d5ac3eafc55a545759e9abb4f8a8902aa9afaf93b6f5ada57a7fdf69aae79389db3fe396bb6eff0c72851501b8957b618307d174d8451ad1386810d0c43c10b58fd7575b4331c1e7f3f9bc1647495d32929fcc8f54478624ab63d556dbb77af67df35f7be2870b37d4175b2cc5dc7b3bf6dd39473e5b197e4afeed54892fbe8bb0fc6baa2cfe3d822cfeb74dfe2f818f94ffc500fe774cfec98f913ff576367fadc9ef852a6f7f9c27712f0a518e21e95429d9341aa7af26c9c6f3ec6405ac980d4d6b1f6f114c34942600c5452844400f4041a5c3515a6729888463ada9b62191ea884d46c3662df0079264448427cd18765f4ddc173f1cd7635398932829ecfb99119e17270f6d2cbbabf8bbdfd5e3e4acafcc2fa2af1bc1327dde6ffa7cf663caf82b551617c069ee452ef271777a985e6776a1cd851d368bdd36d7e960667a5456222c163c6a356ad2ebf5e67bf3f37cc63f6f8ea342afd3ca8248415252656b245f247d2b977d89fe61e757bfbab3eff28b13ee799a7b9f38bcf0df177c8ff6f76da5e9d22f695ffae5b953b710180f7015f7c2834284303c3dd44316bde9322c1a5be65a4908e5968d344d6fbfba5bc545b9b9156545a1e2506e616ea0bab2caee28ae43a0c06f8d447cc9847e63ab2e4826da5a53b148be91440ae8e4a4cf3df9bbf3f3973f30fcc75d2dc386262c43eeb967c8e3b4a473eca39fe5dec71eb967b2f3f73f6e6d6c4cddbe71573235e23c081dfd37b8882fa20c6de924883526ad079a604dac0033660218311e4258665ac962b9c7320140194af3a2e1fca8cd51521788b5fa526dc94420501049c522616b81cf1fd03d4bfaadd6c8d1152be6dff7f8b0154392c9f6f664a27dc5b0c7ef733e339b46cc7ea675d6c864fb9064df0ff5272367b51af52bfd9171f40de82383f96764bdebfec7a5ff8d589f761611d9edfaa030ee81dd0513a7a493b0da60b3a207b0db605f018d04698b21c08267c366b3cc84c53262bc43579b09a27b6842493af5915aa2e7cfaa4d4deb7e35a2219c5fedaf8ce5e7391de575552a0ab6805175adaac686c43e3268344bc6c7d13e7eecb0ae71818ae1a9e084a9d9f19b2d0355626ba8ae4aa50bdd05c151be850f0d8ae547c42a336e18fc6f9afc9f3b8dbef300c085dc8b1c14a03e5da3c651b48fb790de6934927ddfed02fc79ae02770172e0acb43a0aeb923e59049f5ec6d6445bab4f6f86be077ef58985c3872ffc445b3addd6c9bdedf346f7fdcfe879439f1e91225feaee3e97f425a86cb27ed7df6ff8207d2eccaa5f36e572518661e921161256629be0b9d07b8b9d743761b3c93e349426104a8a8b0a0305fe3c8fdb95e3b42397721d8e401d997e467c91d6a4cf223b8cece389b656aa323cfe554b0b3d986eebeceaea6c4bdff19c1a9fa2bb2fa6ee3ef6d4f747a4409802b047c6ab261dd3471a8b3ed20c1c5f604e143eafd5512a27a10275b1a76f2cededfb3115f55da6bbb877c11b0b7ea9c740e2ca18940c68fb06ffdb263f333f0c963ff331fc5f0ce07fc7e49f1cc0ffa6c997ede2237032f389c15f6bf27b555f9cd21f977129412add028231375b60b5586703b03f0abbbd5d1f4bb447f5c16ea836c1575019952172382aea28132035852433534ba54f0f990a579b3e858c94b34adf59dacb0bdf58f0cb0572f630a61336fe5ac4448003dc0b0722e990fc5bd203eb68f03c9ea99d9fbe4047fbbe472d7d3fe7deee234fab31c618232bd19e6e05b185c9d2030b34619198770649eb4c1b59adf758f541b212215fbe3e4ada1da51f3f4ab6465a93051f35522ebff93143e5d8057bf5d1d2eccf7abb880d681777fa7f6c40bb18ccfff0b810cb1a1748ce5d39b29d57a6cbe5df7d67cb5c417afc341ad4be93115f525e115fe4d80b2f1c79e18523dcdbf76b8ade8ed148309c004fe05ee4a3509f69ec56468e85c9099a0b27043bc55c07d96c3a32f3509ea0f761735ef6795d7afb4816447cea6a4db6260b92ad494ab64638e7de7b5f38f282fce97be3c811ee7ae4f6ef6964df1bec7e64c992257dbf9ed2df6f944596bdee4ed9416a6da99731920ee9b6c5a310421ffa883eaa17b7267d91d6ca82882fa92f26bffa55daf6d5af2ea4be850bfb588e5743008ec8b5aa0f631fd8dd32714aba08fa1cafc9b8c9d662b5b0c42d4907342208263177c083a9698fa6693ecdebcb0ffbbc36a38126f3e5f4a19bf7f9ad91efad5831aead75d85aca79feb37cf276f3934312f4f9a7fbac738db5a62c972c6fe380b1c4e07fdbe467dacc60f95f0ce07fc7e49f1cc0ffa6c9cf8c198371326386c15f6bf23363c6b4feb88c7f091ad37572a5adcdb69139585889881fb5186d422e3aa399d122e24b7e68a48818d5437d5ffdeab77292997162bb5e4dbca08f173a1b4279c5c638a157da47f8fb2be55779ff1fa45f3ee9179818d403b37980489b69214dbb47d3fbba0f7a55e5591d41bda7278dae9da9a96ffcd50b77b7b5ddbd83aaffeaa99c658f0f1931a28dbedc7dfbf6bc65fdfdba1dae97f6871a711f6ed81f07f050ee850bd5e92abbb966fc700fcc1bd803f393223f22226fbdf0c2235f58f5ad7ffae2ba4f716fdfafa8ba6f6bdf4b34d2c09e0b70907b6141713a804cb39c9169ec5ee128d6e7aba4af92837de35fa036eebdbda9bf5f1fe638287d1d6eb4a92d46dd66f0249face2c7d3df82e3bb44fbfadfdadd5af711327a1c9f367cf1031ce25eb8114b47dcae1cbbcd6a811db2b82cc7699a614ced053e9fee58be3e4afb6cad437c49df90822bd4d6377eead417b6d28cf7baa938f9749226f4fde933c6b8ddaeb0037a1de6e55a84008dd3577110dc4372e960d158362d0001040a7c019f5e87495fb23050d8366488de9a526d43444404020505ed1beecf73d8f3466f78c6e79ab5f831b78f7b7f3bbca1e1eecbb763bfe9bc32bfef1f68ea9cdfcefc8d316700ec95e5aa4a573a0533689c615213a64937dc3ebfcfe228d62de6c75af5e153040a7c1d5fff8967f1d7df7271efed3d1b3b69daed184ff85267dfdfea63b58e2beb60d4c0f5f120fe9df5f138e947004de97ab7d52288699c20195ba2a17a808d867cb76604a1c0ef0b54ea412894c38dee4e24e39c3e4fdd18519fb8e85aac7b3874eea8d79f6daee366dd37eeed736eec24be7dd75d9d898ff629b357d3e71b2f5f841b2572bd69cea0167d3734531f29efa1091e0fe029f1040bf2e1862baaaf37cd59545fca45da8c05a79a4b7ff4fcf38f3ffefcf38f2753a96432d59a5053e733b3fa7e38fb992787a468446ac89054dfe1d41073ce337cbbff43fb0caf1c1fefffd09c9a2dff8b01fcef98fc931f232fc74155f6fb440702a8d26bc44f822da409d6fb3b5e86103c13cc23c643d3325b8b682c5a235729a4af146c46e1c32a14856dadb6010b0a3aff58ac32cf88c567ee0e4e482693c9542299141d8fc7efcead4de9e13042d3e4ac389589c99094f2b931cbe7d303cab2d6e41b63b74061ff591ec95751805244f0543adf4f1a97971506c862d15c0e86458c7b6077d1c429e952106825348d675af5e63f422e92f54abf479f064356122c5ebe2384412253d35ea0b22258840214c4f2623647711d6522516d8b0cc9446308e5cb60140a190c1e39a6e0f9c73f39243a6f5cc9f38f3f32b4acefbdef0787255327be57924ca6f8eaf2ba617a3c22235f6e1ea987249ee82ba14f4487a41eecfb51998c892ca38cc9c48f682777629569dbe1fe9b3c8a2fa2043534ff81dd8513a7a49d0e621492d5c2e34a8c70381d64bfc329571c521c33e3e07153a74e354022997d869df4eea20f2523c6e738d862b1ce74dad86a1d6a9da0d01bcc2d89dd9e91c49f53d21da81ba004a5a356cb1f56a99e3825ddf6e7547047617c0e391c59dae9e45f527c141935db4c27d96cf7d8264c9d3a756abaa8b41428ad298d472a5182a05c97f9a32e47d99d91a130d93ae0f4c63a789cf8ec830f8e691e62b350d1f62f7f797bdf658b6d48f3d858acb23216abb4cefb34757d7a5e2a36f189c30b7eb160522c35bfa996ba6a9a9b6bfa5ead69d6eb575f4726e4bc5c80e674839504880519db5173d33c942778dcfa76d95de029800bae58a5cd5194d92f1bdb50f3d866fcef3bf4dd67c79225a97b46b42ee1def639f7de3ba7bdef32ad1a914cdd7d41b6ab0959768ddd954523cb5c1b6960a1f15c69da4a420c15834c5755faecc60c53609c1c452205a6ed092fac9cf7dc73f3563edb76d75d6dcf72ef9716cd9cb9e89f28d0dad2d27a5cb73bba7f1c87f8a27cbb52978efb89347d0efdd030a54f24776342b4b6ba528e55964875246c1c73b4650e096c62c048c5a1cfcfb5def7f8b0a18f8fd17f53dea189c4d0f664b2fdf51d6db347d2b091b3dbda668fec3b3a6a7690fe941c362cd97734397468d2e867fa78d9a0ced5dad3ad0e628dc6c968901e0db5056f1f6f552797de5c20e0cf2df416c2038fafb052c5c4a80f9bbe66ba5323e3feabe3c9bb3aeef9ca9acf4d9d9fbafbeed47cee6d9b35b2636161df710af7bd435fbe2b991afe8e1c07a41f721c7864c0f830989f998f87f6dfe00abe88b83c6f131a34811e8034d08aaca18f28fbbc2d8eea705e5d38cf386fab56ebcb54acba3aa62f7bf566af7695fe4061a16a61075fa21746b6b7dd5d5f3de953d3973ffef80bf4427bb2a5ad36f6c8e4cf0c7fb2c3f9d4a30da9647db42da730b0f8939f79eac9ceba96c6da589bb3a870d1c3231ed5c73ee9ab2cc3b401651bccbf53b6b82cdb507c31edf4cb162a90398b4bc066d7ec36ad079ac5ae595618ebb0c56010d36cd8edd699b05a074d0cc98f54e29e3fa73535edd283e5f757c6f2f48d42d5471fc0fdff1145aeb8ebc9fbb34ee38a27cdf8f890ae7e34fb602e9d3b7fe2c787f7236298d98f7e02e06aee45082de9c6501eb3d057c28204530f84b03c0a8b452edd3053ed00aaf2c35595317d074099b12553cc48eb10d9df22b217260b68f9839dc3e68f1996d41b75e3df3d9f18f64cfb9c7bff975ecb5938393dabf5897b52c91133df18d672fb166be999adcf66add50a11c2fc0776bb264e4947f51d2b8bc5fa1864258bbe75d566da48d3468c87d57aa7f262861cf5fc794179725a5e6abc58c88bf8a37647b00e99554eacb5b5b2d5a89548aa2d991f2950cb9c57963d3fefbe79ed34369948a45e58f6f863c713adadcd7cf199d97d87672d699b39f227ddc9214352b39fe99bb2f0aebbeeba0ba4eff0b856bedf29491709b9b3cc6c328c5dbe577304eb2a23c60e9f9e58f106dd7a83e7747767e9e6eaab360b65de3c5909b03d6a97271790832f805ce4aa730b87a3ac8e8ce9489d5a445ab9f68d975f5ef1f2cb6f706ff7e5d97dff4925b32f77cb7e256dc8363173d01afe865cc3fb50992e67e3c89348ccd248887b84da7fe647f2f58d44c058a81b1b507dc51ef9e1732b528996d615cf3de1ec9ec3cedb7f4c0d1b9662c7ed1b73ba07ac63ba3e661ddcf531ebe0ae8f5907777dcc3ab82b6b1d6c94e929d181627d7f6b21f93a4f5f016bc6d644089a65ac7e2391fceaacd56f66ed7ba78c895676cc49d63ff7c4134641274544c7f886bb1d7d1d73bab30b1c31fd69ccf2e7c36bdcae01e7131dfd7119fb0a7d1f27bf9ee32ecda8028b45cc82ac8148bebfb2466ec2490fb96dc0a9843ceccfb84beb578c1a91972c291cf9e8b031a3e7764b8fb9f7f68d07e616fad4e1c49066d13d3be3f71ddff69bbe9dfd98d866ce2e32ef319d7a7bb1d09dada7a0ccced30967c0a7e94b1291ccd7b7baf949f1eb7d2f2eccf5b83c4faed8c7bd7dd1110f3e38827e7d3b66607a01ee90fbc8583ae2b4b0be956662b587ceb4fc0002be8202630f2d8187e427f30df48888555b6de2d30f2ca8b708f7f0c7dbbdc252f7c483abdbac56abf52eeeede3c6a99fa7be3efefcd446eabb1d6324a64e4df4e9e594b665393f3ba08d0ee667daa2c1ff8ec93f898f96cfb4c5528087cbb3c9583ae2cdb192f691bbf57ce4e705f2e46e3d5f640e08447e44bee8b4951e3bb8a6d3e7cec9fbccaa83c7d6ccf579dcbe79b484bafe25120a45fea5ef1b7d9f3f168e44c2c740280478a45ccb45d2a11c7deff31135e4822b90276bc877c798efbfcffcf5e280cb1578eac533e4a09fdf4eb6b6266ff735f5fd512f9fc495e57b76409c06f3337132f8df31f927f1d1f299383d08b0439ea9c7d2119b3e35d138dc1945d56ba3a19890e7cb3386d2ca56aa6cad2ca04a76f48da168df05daded74b2dcfd2ff743fdbe7d36d494c696bf9009f07f33367d806ff9b263f73eed8d1ef9567f4c619b6106ff014631dd6ef95e76861dc35803fb7df9b751e7587afe378257fd487701a24ff913b7c7d5ee88fcb79a118ade90453f609935aa4d848ed68069c9afb2b635ebba3bcaef2c36b1373fe29770d19ff097ded517e57aaa44c9f8ca2c60a23e02908de27272675a610977374096af43d89f48b66ebe3d34c7d472057e97261798fdeb2aaa3a1727df7e4afacb3196f953f7ac84ab525851a638df997bef6fcbdc3f33363d7634b9e7f7c6e6bc2386ee18bcfcceafb61c7dcc2bcac316ca6bea3be7d25352473f8f251ef20eeac29bd72ed68ac87eec4bdb1df2be36bcc893a3f617c07d05f2bc738e33b00217ec0b3b2be0fb864f0c926becff3b003f6ef32edde210f2395cc26711361715dc64ffdbf6dfb7f20756f9adf16fc21eb9b83315a026171235bdeb0a5b59bf27fccf075df6419577ff8bb0dcd6eca5ffb18feff0ee00f37f9ef0fe0b799fcdf0fb0fb6d84e56ccdd2ba7c5fd75f2bdfcb19efebeec4ca788ff74b93ff3315f3c1effd7e95f59e50c64df1af67c5cd93c5ff4396bc8c9be2dfc87edf28e35632206ed24f69f7958f7807f14b7546affb39cfe0f7d7ca33fa0cff073c5df5e15ad91f8cb3993be535e6cb5f9afc0c8ece37ca75ffc07249f93bfc3f64cdbb46b9ee1f502e892fcb75ff8072497f64b9b67c68cd749fe48fffd0da2b9b9f798762f07fa9f877ea6bb0fcafb2ecde273a4cf93b71a855635cd7c7c4a7eb43f84f49fcf91ff2339b9fede7531267fe9fc5c9f6f329e9e7fc01fe14f6d7ca79d29887b2f8727efaa5c9cfe00f9eb7326772edfd37d8cdcd28410c9ffb9e856c5652e7927168828526c76aab8dad73e5872e76b258468e7790cd669c99c95775f57f46549ff5a4fc9daf4102f2a82a561aadacd007dbbc485555bed3512287db883c96926fac92496373638bb4b525f3da5a5b8ded6792bedefd1c3ba78e7a78d682d95f4834d5b65a96399e9b5e36eaf3b1c6542337cf9adaf7c5192d43a78e7b686a634d7d2434a1b3af2ad5706f7d34d6d0df6f9457f810c65e19871fabfa1acc7f0b1fcdffb7017ca7c93ffc31f247decee2f30d937fdc8c7f9c1bb9197568c3faef39c9e9c8c4bf19164d6816f96d8dc3291c7373c80ed8e5576772673f72bc8b9c4e7ad4c6c6314e493af597556c4434542a6666bda9e9e2fa7aa0beadbeb5a50975a8cd0feb355250e97694677dc2f631f592d0ebcc98206377aa881be5cb45d787ab695e77414e2258b8d9a82df599dbc0ead22b507eb4704655db9db846cdf81d1b10ef3c93ff1375fe1ee9bfc1addc8c26b46334de4e3bfd44a28eecd63bdf3f318178316c16abd5b6d8497091c506cbe339442466eafbc791e373dd6cb5da67791c6cb7df639f50629c37a70c55f4fcff587b13f8b6ae2a61fc9cfb36495e2559922dc9d62ed9961ddb5abd47cebed88eb33ace663b4ee2385beb38e9ee10bad149da5242d38594af05663a2d2dd04e4ba1030325f0d1f22fa54007a6982903a5403fb6295308b4899efebf7bdf7b5abca42ddf97f6c9d27df7dd77ef39f79e73eed9ee077a3615cf7f4c90c493efebb9a1a1544d5b1b40dbb2b6a5dd9d89580b1dd322afc9670e784dde40b9de45f7b52a5a345540c46a8b2ac8b1684a8128db1c862816cd2a022d44c518ad7264edd655ad89aaeaf1be54ac5d12756dd125eb7e5c5365af191fe889d282c5ad4f5f51eda8aab9b12669afa9b61b069624ba7bfb9678ab976d6a74bb1b37adf8c4a11a5f8d63c900fd851bfa6b7cbe1af9595fcd214f75c251a3f0e00b24ced6c673b9b50704fad43d7c057861f733e57a22f098358e30f199e71571ad47516651c2a31847e6dc6676bb59c611774d950d2ac06cac08a8529d450586c5678c5a240a2a9b02114d83f2f9ddbb3776f5e9c875d33ca7ebeb6a8d3537c7e3cdcd31d27c74f4ece864576282cc7ce2a644d7abb1646bf454b4ad35a6eedbad8c962eec2fd833db5f9012c080d7a4f90b6acab6605e77925151f4fdefe9e903fb6fe0a6b9d6a6486b6ba4a9959be66ed86f383e766aecf8c11de1a664f474b4b529bce3608ecf5819acbf5940e76697bf90b797a77d6f803329830d51277d605fc19ebfcf57b0671e5fc106087b4da10a4fd0a4fa0a521048f3b90ace8517eea2a0b97e7fa1ab6001f0c69ac23b0e609e4ab2c77864633e1ce787d5f3905f8ed9f26fe6d96e8bd4793cf28c892f98c74c2d42272ad0794af18fa30455c2ed9e759b4d635a2747a44b2d168bd7e2a90ff815af178ce678a50a8468882ee8dc44c63ba7af23babeae8dca64e6f8389bc3b16632930e4e24ba2647cf8e1eed4adc247bdba2a7a2adc9d8a9582b1d472c7389f8c9afc1056158925aecaa715655daac1272d85b8ddc1a20746af027018117903fc09c3e6867d9046f837eb7db1d76d7d7d94cc120eba9aa7af53255b2d2d3602824c593a1a82d69914822aea996a3f86c22de35b966cd95ddb15d5d0d4bcd7a29d5febff6afbc23f8c971cb92a5c9e4d225ad445a75e792d5473adb26962fd993acaba9dcd4b778b87e5774f1709dac5bd6d6ba6c596b9b9247b12d7381dc4bfe0c1c5442104e3c63d3939c781300d0210a80e30a0d11c5ec6c94248516b771fd8e54dd42f580e314c1861f05e6eb3294b25755015405ab02ee1a8bb9b498f96b70c0190d7a5b189351498acf83b4429c91d2a66d4bff5dc5dbd818c35b7b22d1c4d0b6fbcc19ef2718ea8e8e9c1d99ea4ac8ff948f396d0e6e2533e0847ab8e119b340f4baec880d129d91c009300e1ce8f49c6e826dbd8b50afcf9b8c750bd563b39256cecdcacaea6a80eafaea3abf179ce0341a2d01bfd158aca876e799a071c53b6dd698b7f65f4774bdca3cedd571fcfdf74f3f198b353747a3cde4964de997d95c1d6273b571d3e6cde9d7daa2fd74d4fdca7c5574c0cd50091e88a49a80305de4491000081d83283214b165c516d862aedf5711f29abce592be3a1cf0582a342aeb55488a629613921a9f2c979f38521f916e28bd6eeb8af1d6d6fdcb97ef6b959f7835114f24ff123a5eed5cb93931da73aa67349118edb1908af6ceaef6ec9e81d28f97e6d0e0fcf2efc0fce52f2c50fe7c41b9215b7ebea01cb3e5df5ca09d6fe5ed49ca996cfa52816caae84e9a21008ba023d59a85ab0440243637788edfa3199a7a14cb1c30c6e6ab0856786a4d5ee68e9607e0b9ba94cb40db906aa988d86d4b46da972fdd7bd55ca837af349b54e7d6440b777ca41001f9fe34cd600317b4a7920b9a5d7af2cd2e00d50e3598c35b11526c2e4c68c8595d7c5e26fe9a7d6c68719cd4a605ae3bbca9f486d2eb060ffdf84022419a7313632c74bc6de566f929cb559ded8ce726004835d3ad6e5efb847b606baa1a384ed5180a08a0384676f449a83a073878440141440a7cada67a772855a6e8618d668fcf68d4e99de128b3aa50c9d0c2965ad4e233be74efbd3bfafef91fad55afbc82ff3459bf62d3914a6beda4bc5d81552f2922cd8c761c4c191c284009724c62a6f4c325a1081c11b9092660f108d0c19c134600b18b520e5fae06bb2708dac2cbd51a4a955557538a511708797ce53acd30a0b2f578f2b2e4113f7ac391917ffa4c7d3d2715ad6ea704a37d7591942590c1f4ccc6d1fdb5a1baed91f8d1d1832347e311f99b6dd1112aa08dc414ffff5e52c4d6c04f66adbdc2f21716287fbea01cb3e5df849c5f700999010b34a6ea8d08c83c02f29c227bb4402900b04085d1ec338baa51cfa7b96264a5527c7c743ad6d4149b9ee6288124cd87b6cbf7e28e96646b4c7e84cc3c3d91e852a2bb49319901822f32ddcc0f691fd85eff674ccff77ce6003c04ba27119f7848713a24b0068034903494800dc6946d8d47a2738eca61292a8a30736a07f39b6033ad8df43b522e400941409898bfc650ca82a0fa27979795eae80c2ad6e9ad6154b78e8a978559f515364631ee0e85dc15aeda5af71b72cd73ff8c358f916bfd35d58140758d3ffd91a3b87452ee557d3e1b4910f48abfb5e26bdad1c7cf724566d6304fb675d228bbbefb34367c526927eb7b40f1f97a014d9e5daecd8bd9e5cf17941bb2e5e717a8ff2d362f784865b6131b83b7035cd0000f29300f18889ea350e790825d420a50e0f9364a8e74c345a8d375b17da767606bcac721b2fa14fe0b554db9f36b8158843a513796abcdd31da5c7ed2e2b457037b8c3a1a0cfe3aaa9765acda58e32bb82b062bd356c2e4098a7e01797f3b8561c1f3fe20ed5baec149368c97e95afbe5a5cdf189d6eddd3a3a2b4a6daaffd4d7f8404d3f1238db54497fe5bdb504b16768c0fbd5ec087d6646ad5b91a826b15b8d53133023766c89bb2a2280d832475f4e95010f861bd1246e14885000d08128586f2d0023587520e845030e0f7793d6e0a129ba5c2a400a468ee0c9ed7b9da18b5f8f26674836a10a8af7d8dcdeccfe54ded69660d686fac69af5226e7d1bcf963cac2e04515061b32bdf85375feec55d7ab9837788ee38781e71941168629babb0465bd8a083c1dfbbc358652b6d25264bea474d51acb165ab158e0608f27b36394bffef196e46bb8ed9a7db9c15d7ae558a2054f1f4bcb7b8e01b2beff7f24082510487935a35807d3bb0e5339bb8b531c7e8b8d668fa0af52bd6bb5776dd0da7f3aaf5106ab0d995ec2d3b5c63d5f4161f40795060fa836de6208a76a0bfdc315373a8572cceb23ae582d7d2f4d4f6fbcf5f6871ffde8ad1bc98c7cec55f982fcc61bf49deb59db4120f839f62e17006962beccb5a9c02c839ffa0a846283240a3c946089a0b786e95a520667657e271bc61ec180affab1dd03f8a5c9f4abd5fec94c466997d1f03f311afebfd979b679345cad7388bc9d57e799ccebaa77f9ebb452260345006431d313ffe9f740673f7ca1a0df162a315352add749a2aed06a4971a31157955bd91882026cdac7a578324ec791b4484c8c6f7a6ac3d8181dcc7df7f9aa2727770f1c6e9b6afb8f4939333835f874b5727a470c80044833f860cb33ae4a8282b63bb1520a288cea2491a3a48a275a1c889d31197a571cd549840a9ada4da6dd011f78fd4693cfec33ebf5d5b31929fda230d46822ab17c337bb8e54da6c95dbaa2a1b8eec138448c0bb482708911069ee4bc93bf113356e778dbc8ffeed96f791e09a5063436da831c7df9ba1027abf58ced8bbd27d33db5b30737f87c6e11d291b9b6a7379ff50aa84e5a9312fc8fe0bb8ff75f330ffa0c2fb597f18bff96b3e3fcbfc95955b957294b8afe327b8e3cadce08eab7363f6b39a2ea83fd34b8a493354c3f017ab98fb494e19c78f8a026154846e7f99af95e2dde848b9b3d485a9b77ae654194a952a01b24673c0c35403a0051ec6f36ca979e31f69dbb724d9d5108936354519143a19141eefd811b9ba398cddf3c1c2941dcf8b50b80798e553dff37e7cea153194290c130592a8e6531f89c723f4523471c7779f661ef5a76249f6316bdf955e601f985e601f985e60bf979e67bf97abffad3c7ffa567237d82000cda9c60a864a01395c5be050df53e0501f9ae550afa84a1538d812598f7a4d51faea88b7a65281c4c6b652d38a5824128bb74462e4eee150477963d7e9b1e30c3001622dffa90a94640cf2fc8d72fdfe36ccde8fa6f3e4000eaa33af930ed2cf7ceafd8a4f3de189abda66e5f37cea0d74a6aa4ef3aa6d7f8eda781e9ffa9e396a63af7b8e4fbd25e7531f577dea930c1cd1b8a4f8ed76ac0f5e3fd19bf04ff4fbae9f5899a893dffe94cd17897fefe1aa60244efaa7e27da7c78ebba247626b4e8d1d77b6cb37feaa3419937e67d460c2c6c8781a9d2504c5021c9bb230996de7f043043ae054caa04709dc287074d746a5847aa034893f0a7a20bc9e8c1bb2a6bb9ebe621de13861779148d8a6dd910ad3ca84e74ebe8fda4329472000d0968c450391404b433df8c1c7ec128192fca8ced9960855cf129aeb7e9ed4ec1247d7ad5b126b9744b13dce8c109535382ef477c5db45516a8fd55657dbed3535f64f5e5163afaa316c5a81e20ad504f1c0811a5f4d55cf3afa6bc8eb42d1e5f5d5c8efd6f8feb68e7e63b6865e460be6b5356463303a7293a14fdbdcba15a165962922af4aa1adc132dbd660b368746d7e5b03c72b5abfd6587313dbcf92664d41fb09323391e87a35d6daa6da1a08accd6c617e0b741cfb72e36819d83a8f51649eb12c7057e1fcb3c732c76e625cc86ef2f87bd94de43f7037cf329c40beee41d19d9b85597881518ea91b723af1bcb1305d43816abda04a56775e1708e4e9cedf43d1f0b1eba6793e4f29d9aae9ce83e9201d80369e7f5271c234b008bb0048988d635b817c6057f2d19193f97282b6abaec9de9c252e689beab9d282c5179fcd2dfff7be9b92cdcd89e9abb0a4b79b341f19ad75c5db3b14fed8d69ec9287da3b4054b0af8d0ec724d0ee8c95c2036669bbe47b103f9801750e08f0212825732272ac55c4108ec1655eaaa8cd79fab2a2039b140dd5440a986272f5b6f682865467039ed55b60abdc87310c67a496f0d0bde60286ab5460b7dd5357b5081cfbf0f2dbba6b869d2146c686caaaf5f363c38b127b8fd8830cd857dc170ad37b4726cfbe109c3e06abbdbef727a4443c9dad5fd9b372eb7396a5c8e6ac150dabf66c3d64c468109835579010c6797e76058cb60d8092fa50c46d4f1884497f3fb17254e128f02c74b1c7f02880e75e4a8928939cf3e063caf1bd5a36682ae542d72739e646acdcb3cca2c729c2472273fc863438a49ae133a22a68e4845852768d0d7848579c306de2f2e9af38c73957d3bde1b2f79b6ba2efda69ecb21697e7c3c9f1743e06731262da945b5c842083820c89109cd49a25b9159052d84c0eff17b0292b2b5981542a07ec9c51128e967f0a7ab3b0eac688fc6bbbae28b1a9a931d9148fbf1d6f1a5074fa6daf1d8f0b2aee1f8c18e68a47db4b529d2de114dff8514a5c612e35bbb3695d13e7e5cd52116a931c86ab88e420ccc1e2f0bf5cdaa758dbe8f1f1c7ae6096ffd09e2debcf770bd2ffd734008031023f92a98209e8ad07ddf2d0273eae791713151841149db9e1acb4a8a8b0c740328f06042932eb74965a9042c3e6305dda9ee5f3774f6ec89575e89de33d88fe593f7dc3329bf159b54e0aabdcf0e6da904e875fa5b44444109045062d60da8d3b16d033fa2e8a1ec559536a3d168f678d5ec42a8c4a655a86a95b81213c0ba408caf3cfc70f49e07d70d1d3b46fb805fbc62f7e2d8e460ffe2dd574cde738fc243681f5a390938b0c292d4621010500036bb79847109398e1f1691ed52743a32a2571c8a05de62a63b600508bc55b01acb0dfaca30fa92516e1e30cc8c6e3af8fd2c28c8a17397de990b8fb5ccdf27988b4be8f8207109dfbc663ad6dc1c9bbe76bfe1f85edc223f166f6b8be366f9b37b8f67324adb6c6edb0b68d0ec726d3f31bbfcf9827243b6fcfc02f5bff55a6e4c7bc8ddf3c625747cc0b80494f735d55ebb7f4219e85a1fb9bbafbeb3487e77eff1fc01fbb2fd5994d79f6fe7f793ee13d4726d9fa0e9ec3970c322984e195c2811230a3acd921102258ffcb81e7520f03a610224898c8aa8497823c0b45254200640b1a026ab23499acd2b577b2865f4783c8b3c8dccb261a493a8268c51c967fb00f68da663c337bf7c391b0767fcd8b6cb5b39186c0632e5aa0ff07f17f80cbb32e54cefc3744679e57d9972a6d750f6ce85f5a93ca3c8061cf7755472fe8733b56cbdfb287c2b91a00e0542e16b667b0e9da4bb0504c213e1284b2fc38d29d1407a94a48eaca0c9849f705e65fee4e56b0fa54a7d5ea3d152e1091abde50625d505a317f3a691ca118fe83df797b4661dc66be26d0a11a1eb35cf6fbcb77443375bc885f6cf057cc77bdecb77dc329fef783ce73b1ebf8cefb8a25620cdc7779f9eed3a7e6aecb8fcbfb25be9796c543999a49cc91e0a0fa438ddc4cac39972863b2fda0a70dd9aa957e7c033053ea58a8f5d67b6fc2baa6f302dbf9ffb50d6f7ee77aa6ff073ac7eaefcf779be7a2bb847b2e57f28f0e17b365bfec76c79bd4a871ae7f1bdbc335bff370b94bf5950fea56cf9ff29287f225bfedb05defb9d7c5f65069f97e6f10deecc967f25cfa757d9b3bf94dbb3abe50adc5e9a03b7f2bcf2dfe7fb5433b8bd540037a5fd67b3e57f2cf0a9a6fd6f9bc766d5a9da1672785c93a967f6152ffc421dd7f5b3c69b5e60bce9827672e34a2f30aef402e34a2f30aef402e35a3a472fd6cacab939fab5fcf27c7f8756da7f565e88af568a2fb5fe8b79ef6d257767eb6b7058cbd64b50e53ff9e5ea7a51cbb5f619ff64fd71cfe1dbf9e55a3f95f24eb57c763ba66cfd17b3fcb09ef167adbed69fda4c3d6966fd1c53cae95e9a95f79266d6fe98dafe685eb9492dd7744d94ffd7b2f1ba29ff57a3dd799641ffa41afdc736e9d0efabf2684ac439f13389c298bfbba6fb9717a9b4cfe7d97590890324287f76e050859691c89ea894a68635a9205f46096479ffff5720bb98b2e55adfeb98bd23383beeafe33de2fefef3a90fef2b2f2d2bdbf3a17f2141f95f625d5d515c979e816c7e9e652408560a0f739192438705fe75cc0efcb3ce0efcb36a917f2151e2f66fdabf48128db1d1a84e2fe99af76cf970b351af8b90a0fc8596d5d7e17af90b2d6bd6b4e0faf40c6ebc7a65b3fc3965acecfd6cdeec2c984fb3cbb575a2941bb2e5e761fefa9abc1764791582f3c4fe75bcffd8bfe0d79ebc6363497171c9a63b9efcb73b77159796940ca3130d1fb1d4d4586f952fc86fdc6b71b92cf7022af38e04a108bc291715c60a90d4c55e5604454ae89f2d99c5123edafff26d7b4bcbca4a76dd2ca79ffe21d1c9bfaeed5912427b3a989bcfc6ec7cd6e034bb5c8393526ec8969f87f9eb6b70ea072006e69b3037f6afe33d62ff0cf23274c9bfc547e49f61e324ae3c32293f4bdfc5da64efda5fd0e7d9e59a9f8a528ed9f26f1a7236000369063378e89e48754a052a64017f1250200292936ad29109916ed984214d852690f51515159e0a771df33a15e7f73a9d25c1de75dd759caeb793caad9d8adeac8939f205d333134c6d367274b6da0c083c8ae7f1b32c4e2f08a7b271288b06b6e6824b742800c70bdc841665a24709401aca06a348b0de918a66635140420970fec750790c4701255cbf40484a9143d5b4cd1ffa60cd0b49d9bfa4873334bb1b925d91feea4a6b355926f5b49486fa2b6c4e2b79a3a5ee9b49a7bb39146a72182dc6d260e3ffb8ecd60ab3c9aae0e873f81c3e465e67f62945ce241c90090139446e286ba4e270fd6c1b55912d6cba8c8deaed152bdada56ac68b37b3c767ae173a9487774f1e2687724d5e6aeeaae72b9aabaabdca0c5cdb19ca32c6e8ecd272d376f2215d572f26ada083b4bc9cb0d5339d8c115661029f1188d3aa92a1c657923b3595de2d9bc2af88cfcad83ab0e77761c5e15ebea8a1d2233c79e4c8cf5f4ec491cec8cc63aff4bb34dfa597fcad91adb5b90974dcd8863ef630ef42c2f1b7d21cb54f9fa347e517e81cc287903f66a79d9f0682e3e4b6b8f6b030916af7da264602b6d14915c096ace61ad6947ca4239db2db3cb879e613911243b7b6b3ccadedb750d7df1f02b40c09639c6e2b34ac102ada9b84e201c701b4114d556f412e10098478683ef2f2b0328b394598ce52c038bd1586134488eb02d1e4d249266e65612f75802461f278a922d6075a188ff909a92bf0a99070474986fff6bf0680aef4d7fecafb7dffe57c2c93fea990ce6c51332383a72bfb92f8017af62f0f8beca1f8b00481ff30768492d2a214422d8cb23a16b8548fb598e6b7b9f4e246cf0960a23f39b309aca0d12cb0acd45cdd1bc147bf4bae6f091f12343feedc1a1437baf383c52cb6de782780cf7c95fc2d5ec3a279fc641f9511ca4ef6fcc5c607ab93ae84975d72147899428f08248891447384aa478e090e7c64110a4519024579f66c97543bfd7e4f7f8bda6804eaad1f474aa72788eae4e8b5baa10259c59d37db4f7f06de2b4b02cdeba7c69fbd8ee78cfe264cb62fe8478eb81c31f5ad28ed78c2cefd9d37a7847b8a5abb9a57db26b516334d6b0eb8ae1cddddbca67e7b22b16b23ccbae7048477e2e3bb626a2c60ac91c35fa8cb124b7ea68f177ee3f5afa229991cfedb883f4a783b86dc77de97fc9e687fb1c78f13a86a7ffc893e1db183e3b72bfd9fcbea1607e1b33178981cdbf3e858cda0a66301965d9fd1c7403eec8bb43882397f6cec1aba6875228311a4d1e515232dfd119e96373d167ac5a81227e7cfa9c80a343c2d19bf0b7e920d9279fbafa04dd47a9390609f480b6a68bd8ef709e9df70d202c2654e3a36a0e925ca708aa8b3cb7dee8eb5f9cc6af4e9f2733f485906d3fc2dadf946dbf92b56f64bfb53c25caa4c9e582982d6fd899bce1c89337a4ca70344fdef8dcda1fdd76c468d095edbd45bef415e292bfd6d2d1d98c4bd24180fc9cf5786b0e77c0416de637a49923cc17390487d63e61543cd918dd110084490e518fa284e2019dc2db748cb7f12a6f0b29a44800e1d6cbd61c4a958682468bd168331a6d6c8926b38eedf1a825a239335b7c66955368ea88bf76ed8ac576751d50fff04dc964d36efad198185dfcf39edd89152b12bb7b7ebe7834b1821c4d257e1e5bbc38f6f3444a81bf962b82c0550cbe9d00444766c00475a960a9725a1921bd40007802073864538ecd33a3c967f60a92238ccd8a6c116516c5a4316af4e1e9e6d5bb8f4d2f8e77bba7a7d16238b6fb9d3fb7b4197093967fa33f738c7469796a29a037f148885d71ef180144076a796a256758607e7aca7fa46b4abe79fab3d36b6fbf9dcca4ef27e3e9fb0121a29e2f5504ae9453257db4459606d401796d793c5c34db56502ec183bbb7ef3e4066d2d3e4267a29fd6bc91c232b587e9c50cacffa27d2c567ef930432ab87466339a560f97d646daf382c6fddb36b7078ac9df5f42439a95ceaf86f62eba6085628ee0f95eca8cd49050a74218d08c8dee148d9e803b7cebd3394d21780289bb2180f9e7870fafcf483d3d36426fd09b28f01bd30e71a955910780ef971261a3087e0114159430509d78a3c464962b9de72d9de42b97c6b53ab8f74751d594d123d3d89493223bf96d8d3d3b32779a4b325d2f953262b652eaaef9d2d9700a8d4e2fdc825bef87cefbf66faddd5791d58498eee9fdd032e6f6e94419512e707a280e204cf08878e4ace239ca4d056633940a5b5bcca5855565a52cc52c31bf5b9f13389cde33346515d7d3e4be4907b687cdf36dc7fe8907c5fdda245756e0a867d43ed437bc94cfa5473b8b5a1f9bf291cfe96b948d6b0f3293c30a950780f10a0dc7a02785e933228c3e646a9f441c1e148352c54671838cea17c5722b71d42ff50ca5a5509e0aea9f45479583444c063f650c144a2dc940926ea28547610c80e4467b6bb51c466e78691910d378d0964f30abccd5b1bf239853d6dcdc424bfb67360e7c04e72bddcb7eb8a3df5feed81fabfd179d508c0c665866a2a3771883a142414260883af0125891fd18b84510d4b0580d35e516da966810f018fd1682c929ce1ecbac9f5ce98eb5ae3fe93e3ce0d23c31b6f3a899c2f14f239c98cfc4bac967fb96b60f7c04edc297f66b82e301aac93b55c648c876ccbe666b29137c045e514baafe039e124802889209d04014410c4714d2fecead36981ef6ea4dcc4053546b3d714f09af452b57a0442365d6936fdb9620563671e4c9f983870e2c4818913d3c96834f9a31f25a363ad512d49e9f1b1686b32ba777282052c2af6faf26cdf2e273dd16e15884f4adf4c3ed63bdab76c92312d6333b3c4e5928de119a55bad11a55bd1e4f4890925c398d2b1d6e8c4e4de6892f616b43c158c372c02c8cf9dcdcef3cbe5d7d1724b1396f3999d594616b1799e4cc52414382bf202e9050e28e4d9acd016be3a2900c006369fd967a4cb5d8982f1587c96a41aacce1c8e24c9b87f3f0e1e2d5bbe6eedf6e980afa92948c6a7a65e2a5b7160abfc0a7a9b5b2221f9c740a039738124c81b60011f1c51ac471ee000248e0596915185942a9b499d4054325b97adc308ad1a81e6eaa3a49e555616999b2d32ab15c0eab37aab1d60810ada6f335d647c301e679e36c6687ca1ccbed8b575cdc1836b06d70e0c0f0fb8fd7e37bd0c639bef979f2533f2776fdd3c36b659be71f3d8683888a782e17050be2a18d6d69941a5a3e154ad88021014483657a79d79dc3ab0bfacaccc5666f51a8dc6a02439c26635889325b3e77ca8ed80ffb57b65c7a155bf9e1e3af10f383241b77518b31d5eddb33b910e2a79217fd6198b762838cdf4329cbae93cb5208ac54890f482084844a4fb5ec5553d278f2a33b646ea67b3d5c54014f0d0792ae43c4725c9e82bc43525ef684e35441882a7b3086f55923323afa03987f937b3f999b53c5444c9a3919797d90a2e76d601015e20fc38701c5b442e16bc81a322b2c56eb301d85cb61a87bdc25456ca76eb3a697696664e5b512cbb0505e337aebdf6e0c16baf3b7078a6351e6ffd74eb4c6bdc70d55e6cdb73d5557be4e7f75c8d4fef48747424e49d9df14387e29df9b9259a8128768c6c4ebc4a08d2555381028fbd22aa3a9ebccdb3eaf9c5d6bf9f4ebf4ab079020149ca73f68ae70790cde7bc76cdf8a1e93b6f3a776ed55a22ac5ddc116f89c4e22d2d71fce3e49ed57bae3ab6efaf377ff4bafeb5cba2ad3f8ab7b6c657d30ff5ec1f3b09bee7d93fcedcd93f8ef9cefe91decfd93f33a92b46d37f1e3edc1699b92ed0d010e0eb9b5bea4e60f99296351b4970ddf2c6c5459ffb88dfe50ac8f7063cdee09d80702d00319360a1bec1399fbee1c2347e42fe25092afa06f61cd3cf7d262fc781d6de47157d43f15c7d8373017d8373417dc38569f45e435f3cf11a10a862327070017d83f3efd237248d3e4914a5aa8095b2f20ff74cc9e7dfb9874787e9f60b55db3bb1585e76e1f6db2fe0ba073bb757e5f673741ebe54e8afcccedc54f5a880a00720eb48707e5dc33813b89d97d535d89859570dd5b0f8e2be1ba6ae18bf726b604760e7c1f12bae1809ee08a11d8bdf7efbedb7e5bfcabf4ca7d3ecbdf1cc3bc44e1aa181d29d86f7a563f0e433c98829e6f1474cb1ac8ec1e72b7040cbf30c52b50c9a9ae1d52553a3f16d57154f4b91603cd4d8507be2da406d6da0c9d7224e171f1b6cda75a42b8e95cb5bd76cddb8aaca1970b9fd7bbcd5d5ee9aaa559bd7af685aac2bd433cfd63538df8faea17fd278fe1393e5df2041f94f2baec197d23358b6e2b0dc5da0537e2c67ef04ccc6ba2c70ce8293c5d038b231349212439345ccf7a6a7372831341b48f0553584261b43c3def7b9823562633a8be03c3a0be7823a0be707d35948a2e8b3552d4711ef9cbe8727a35b85a353b8223d83fff293434773e72204a10216a5c2c6d2b9e72238fbb45e309fcc8a0aa3d5485f52782e023b16c16269bd679959af33f7dc73ac64e3d12da524f8fd8e9696ce97d333bb6efaa9fc4b747eefa3bbf2f03ae72c991c848d851056f208bc383d7d7e7afa3c09fee847e99982586eba06d30573a640a7e27c0f9d4a900244d5a9a8fed2040decf7cacc056261ed7f33c3f6b62c276f7056ded81ca154dacfcb1bfb55bcf0557c6a6a4ab3a7b058aab159f6952fe5cd430ec2995e965fd00c0ef0b10cdf8ac8258a4adca4b32fabfc0007e56a3542bfcf6bb418d9ff0ad9501919d37e30ce3657f7f1b791c34786f7b30fa1299168da453f8e0cdf317cf8e9a78fecba63f8c8d3e49ad6c81d91b6b6c81d91d64c266b1f23b89bc1ba1b8088cc5ec6e2dc94833a72ba0ea7a6eba8f0993d82e40807f2551d89449262156f5bd47ee09ae9ce98b3ce4a4e9c78d630b1eb89cf865b78012fa567b2ba8ee02c5d87f303ea3a82721f3e25f765751dc13c5d87f383e93a82720bbe4caf7c5d4770b6aec3f9f7ea3a82720cbfab5c79ba8ee0bcba0ee782ba0ee707d67504e57efc1766e825b041c5b301ccb3741dce425d47711180a9bcc85c6c0603e8b3ba0e2db77c56d3b061face3d9347778b0d4d4d0dff40824f8f0d6e1e3b12f2fb432cb71fdbf34418ddb5cca7e37016ea38662b58723a8e39ef3d79323d927df1767c6a2affcd5c762e18b2da953cdd8673966e23a75a292d292e52c6ab2f1cafa6da50351b81a1fd135b71ecd021f993e1a6a6708004ff756cf3c65112943bc2b5758b3ecbf43b99cc45261b5c4eafe17c1f7a0de7ff955ec3b2a05e03cd76170ad8d0b866fbb635674639dcbc1aef76783c8e46613cd984bb9eddb2b275f946fc7606761ed9e3b2b73a3d9f6738a5b0ed65b4611ebd86f3fdeb352c5115af73f51a9103378c2e5ab37dfbeabb6ec02287dbed5844825ffdea57b7ac8a2ddb8406f9c2b0db1e77781ecbf38f66745db1aff5652e103b697e7f7a0dcf7be935d4189fd9d99934bdc658929be6af1f3f303d7d60fc7a7e9a4b3645938f3f9e8c8e3585771ccccb6865dc6365aa0d4058a2f2b5f7a7dbf0bc976e430d6451ed4339dd0693daf0e3b46337f027b8645384762cd294e44ef0378c93e66ce75aa3d63dc6685249b895c964f32910f845617e0026f512e671a5c62cb3386e02bfcfea378ac90c54435b2a51c5024644e425e4743cc79c4e117438018290b583a82194f49fd9673628b2687ef068d65d59cb213132cde227fbfba7efbf9f23bade4efcf3a1edf22be866e1936f90964d9b376f5a3991e80202e1cc051221cd97d777382fabef7066f51d9e0faeef5082a416b46863e79681830707b6ace91b1eeeabf1f96ae865d8d1dbf73b12fcd5aadeed3bd6feae77c7ce5adfefbcb5b5dedff96a19ec1359b9d93aafaec3a9e93a4a4b4bada516afd1680c4892239c546318a8ac25e5541d5f6a4b1ddbf73f370c5df951dc3ab928165bf41dd396cec1dde999af7c055d0f8583817a55c741f11a80a5a99487f94a4b28e890d70bfcb801f540383d990051646a7847364cb6862138007e05c1014fa0487269da38d585f83298be665facb125391fbaffbae7d8b144cb3b7350cee4ee5ed2c3f8cdac9cdb4e6608abc9cbb92d55866d0be4dc7e7a56d26d2e2b8b96431578e7d399780a7526763b80dd6bf7d454db2c262394435950d599649304318d7d566d62d5d426dfbaa175ffb265e36dadfb975df9f3f644a2fde1f69fb7270cc7c74ea776c7e3bb53a77b76c7716d65bcad2dfe6e7bdce98cb7e79f69cd81814a3f3ae6150600f5d92377ea90edf575556173d4ec4946599ef69f7eead9f41feffbc5a6b2d2d23232930e1ec623cb7a7b972a742ac2e4e13720041f57e493b088bc40087f14e84801b196b6ce8de824c271754a1204e53bd7ef50dca7ddf41180fc47e8dad79ea1fccec3aa083c9c5ca8ce90121c1f8290256034866a8d7a5d75381a8fc68385e094e8fc89470a569925f2b1d6fe8e43ab561deae86f3df38aa7ae3b16ebaef34c09fa81956f2dd99348ec59f2d6ca757a71eae978fd5f225d5d91bfd4c79f86b936551db3f92920e50861f0546caa4613afab0c47cda128aac9ef7d3f5a7b33f2b7ec2dd3198c4788eb2be9202e69eeec6891bfa69cf9c7d613950b1a53f526e4107be978c3d99c5e0d7d74fbd798afff1575f6b06d5664853718f245ac365bef74b4b929363db9bbbbb744226652fe3499911f89b6b64671abfcc89e6311ff12db3f034269e62259c5f8763c1561f231d3a1842912eb949c51120234403f82a93c2f8ec68c669d8e85a8295a5c4531c9fc204968d39e4f5eb786d4f5795dd5c2becd97a8ac79ece8455f1df351c9eaca4bc008eda9a48123bcc016a484020fc238f084f08394190f8b1c213c59c7fc828ca5c6f23275ffaf13679f9bc76114b77df802dff2e10b7c19d62c915f2733e9ef6f68c6cfa783a4796393bc813c953e466e070e96652e900af20618c1058decc4705e8702c7b3e007f5502a897925894ae8bcdb6d3201b81bdd0da180c965aaa9b28111ca437a16425f78ced16c0576b0c07de9d7136d6d132b56b0cf58379d75dd31abd369b5391c364362f7126c5fb23b91d8bd44fe76cf58e2506704db239d9d11f9db91ce976baacaaa6ad8079b2f1b01482d3be7c197728b02cfcef66381b194d6670f74ac306a0e7951a3144fd2ed47addcf0b5af3f72f7fd47307de7cdd7a6ef56e675073b6bf90da8a2fb4f44c5c50f3840ee24534d284713711c3f2c28d96400a00aaa6c5e5388397ec693092d54cca742a34294248f65dd1b2d8dc99678e734f9f0e165e3adcb7befc3c8b5bf8aacab8bb47725f65f1f1b59bcf443abcf4eb23ea87add1a4a4b1d760bc734581c8f3ccb6846782427b4b44cad59096831f41b4d01afd927ea9de18096c34cb1ea84d493703c718f45b25a2da4bcfdc0ca1507da4fb4c5a2edd81adbf029f93778df3f0eed7b61f1702c36bcb825d9dadc16494c9e3d38796fd1158380d04337ea6cbdd7a742124f942e51d0e07e2d0e399ba0c964521334f9e29e3846910ab3849b96bf8255b7cbafbc34fdb12fe2ddf2dae03f01c28acc05622233e08115a9a5d5c849d84b775d9c122aca2139a14389853152263a77c8ea21c346965b55cd0b324be853053eb3c7e251d8e713bbaf3fb4f73a719a8f3745938998aba9f83a01cfc93f25c4b02281ff756874e5d8d1bd83c1ba446465246eb7e199c97ff78580403273817898ef9487ee9a0cd9d9c12377403d7c8c4ace7497b898f433f1c763753bed54fcf1db247d255b2631e6116ccbce0eb654b299317f794597d7f5b9d3af8a15cbeadb079bbbae589d5cb62c492f43d715ab63472368931fe75deee4be252b0e761c58d9d6b1726547db4acd0fc34d6640076bbe484932f62a2a689b960219513bba55cd5b52994d553ceb96aa86664701a18f6989d1732bea7e3b8d6f907d077e7615ddf22bf078031c108228a51f8e12c22bca10cafe2798729ff2ac6ec6ff3594d5d63a9db5d1dac8a20667c8190c3704b207fb6bb94393d90ca26cf510d1a29d53e533e527119d59d975c5ead55774ad4ab62c6e9b58b66ca2ad3bf2687b7b34dade1e6d5dbcb8b5b57b71128f8e2f5d3c1c8b0e772f9d282ddddade3ed4d232d4de3e5482425b34d6d1118bb6a57fdcd3d6964ab5b5f5287e9a4900362e15cf0481d9557800c2b3044beaa0d453ee0af06c09fad92977e650412654360e2ebff38fe4617835c379b38a67dc9445af8271f9ffe03514c714d785362a8b7222bcbdca64e495a41f8428894e5c4c63a16d8dac56ca39acd55667a9a5b48259a924c99667fc35c6125151b458924106e2b864b546f1ac769c68dbabd816513e95532e34cbf481f6d8afdf8cb6a57fadfccd64e074e6367c0997a384bf0748a741ff24902fa7d3d93370cf66cee08fb00325fc83761ff3ef9fc99cc197d9f37f9cf7fe8732b7e197d9f3ff3deffddb3367f02976ffad79ef9fccdc86cfb2fb7f9af7bed27f7aff7fe6edff473367f05becfedbf33e7f367342bdff6780f4dbf43ef7e5f4dbd9fba73267f07b6c7c7f99db3e10388da7f1256ed5ec1c361bf272d86c9c9bc3e63dfc835f1f1c5cb36670708dafaece472f3c3db0e2ccf28181e567560cf4d67acf784321ef196f2da52167f12cfe88eb9ec34f0772fc74bdca4f8bec61ccf153fc91fcdccdd7ef3e328e677bd1b475db6af933b4bd3378165fe65641e5c2fc74438e9fae67fcb4122aad949f16d9c3e639fc94b1d3a8e57f3d5a610bbaddc14db869f9ca4d8b42c77f8867775e5d5a6f758742ee25ebfa9704d6d8d7ad5578fa87f0347e99eb063bec7bc66e61ac6bed137ae54051ca548fcee2a9035906b3911249ff02acb7a0da504a6f34f9bda68058a41d4297e754a19e43178f5a704db463f3ca559b3785bc9e40c05db3f919997c52b722b6a4bf6f89c71ff078824bd6addda4f4fb763c8b4f71dd0bf1dc812ccf5daff1dc2247187d715f3c1995d8c9f04f6dfee1c33bbf7062339edd3afedbbf958f28ed9ec4d3f82cd70dd594e73ade17cf2d18aba699a0e3f5eb8bd480f9423f5cca7199249c88e2b1e55b56f66c1637f1a16a4f30e836ba741bb1e2eb551de4d36bba96f4f724ad8e90d7132a2db9d07b5d7d6e0d7483096a28fd2d55f31911e078c21d00c401c668b5ee98cd00e61a7375950d4c60b4faa5a2ca6c8f2833494619dbafc8e71cffb06c70f5ea219bf9e0d0b4545a6babaff7fbc2611ff9d4eaae9e75eb7aaa57567ffadfca8cdf0dbae96c720701e1a37816bfc57517f0d68a7978eb409f828f7978ab764be5ad74f9f8cc2a6ff5eef8c2dd1b308e6771e7e2d3db8183b3781abfc5ad021bf8a089f2565bd1fcbc75438eb76e847ebfbfb2d2dfe45f545f5be9abf4d6d7fb75455505c0d08e79a4cb28b8105fbdaf61e3f2651beb7dae407faaa73fe41af77a9902c6130c7adcc1a09b1ceaac6d5bb6b4adae53af8bf9e28b17c77d31ddbd6e678dd75be374cb5f0fb8dd017a0181537816bfc7add270a963d164949752a63ac1525351a6b541854d212efdd62c2e25c997cc856758f37b7b9ce271db098ac7b61543abfde1b0cf1f0efbb955eb7a9c2bab3ff36f65468ad5ffa088a40885021f640ecca972255ba022bb9613cabe7d18bd6f0d1e5dc33c43bc006429f92994433d3cf4a4b86759ca585ca4e33808551083ae16d1a09df1dcc253da760be82483a43b2a200744cf91fda00749a7970e14a101d13058c48ef310110db8ce918ae43f6338f97e1e1a4a55188dc67a639dd76d0a9a8c563a9f8af5aef093222cc3289d55f1685c9454c26cf1c493b369a824d185f98d55f29dabbe77f198b1a26bf5be6d28ca7fb935e2b455d5f4e3ea8ef6953b5705aac94c3a88f7a4be5f551e196c932fca077bd6adadf6d91cd5b6e8e28ee69e48ad4152f89697c94c33500e4ed8c960b4418191a3921844d2ab0e524f44a217f306291a50a463031d1a74fb991f838428e23aa3d1e8343aed5546e37b0dd01c8f5a34a287cfad91ef5cf3d23bca9086eeebeb7bf4c4968fb051dcddc346d12a5f921f5dff726ac7a35f2a070e2c6abfed5003f56855b06b33128eaf0b92621de9b5228f6b15154f4f8e5d10bc128a400745ba93220ac073023f0e04a088c001d0154bbac1522c0683546cd80f3a9d34ac2f21924e5aa71e34bd248fa3fcbd2da596ce664b7f472b4343a91a97cbe170d5bbea6b434a4654478da3c6c668bba74c5fcd400ef3f332ba93e4308a817c04b8567774adee73d9abaaab2b6b96dff3fa4043ec0778748d7c27762ac8c0173bda9bcc9555164b5577b2a3a35b4cd6ca3cc5d0090d2b747dbe90b98077c1235002f6948d294d8992230841495dca297981f25397ba029148c04e3f0cd160304a2fd6d63d9911bc15ee050e1a95655aa48537b17c86e52c2dcea05a86b06ee869850a083e7314f923030f3d84744b00dfcb5cc0536a7ec1c6543dafa565651910b3dbbeb25284fc24bbe29c1cab5c61b7bd81a6667f85bfb9295091fd86b735fb034d4d017fb37c847ed25f6c9d9dcb8ce069d60723ac50336aceeac830b0204a47caac9ec091bb4fe59432845cca66616ee7e8a0735d6a3c32f050ae33e9572924e81e249ab94002f00483451d34a51a58ae4e1cd50912270a4c5be47452315539aa3c4f58d5cf49b818b9ccaf8d6eb7dde1f138fe71d65f83c799727a16fc60b07a3573114fb2583216d9aad9555b1574f1caee4d124b8b44b36432950bfaca70c0c7c5a3965908dab6c37adb465730e8aa710583eeff83e3a74ea51f0b542b3970d97b7af12409430998a13615c882bb231f1d0826e3c25037fbe2e6a8195dee50adbb8a0a1dc9dbac3bacf8889ff1dd1a7ffa1f3f86a3f2830aadbd267311df5173c25da944b50525e43991e38f52e4f0c04de4a5a81545c528d326f43b5221b5a278f2f235875216ab05a0da6ef15a3d500115568fdfa3d7dbc3c9509e1394a2bf902c52c160be41d6453a3ba3673fbafa70676447c70a057481a0eb272f241a1ae3c7e3a33d9dbbe2b8ed677930dc91d982af31187ae053ea98800796d1900341e484fd521e5c45910ceb94606187920d2f446b8372eccc65aba7fcb36b82a8432292b1dc132c1559154b9d5d63afb4592d15a59e328f823cfd7ceb99c956f1a84d9549a216dc48b1c85059b3fa70e715dbaee006a2ededb1b3f8cf819a1a9fbfba2690fe426c78f1d09564db0b2d0d0d2dc795fde10b90c1bbf04994f05d00d42bfb4bd4abfb4384c7e0f7f839fc2314414dcac15c228668f92841045ccf94ee063fa7b785133941cff7f6aa556d6dab56b5fd5ef9d3b64a69ab0606493bd90db5949a55555694e8752202f6063c6e1e055ccd9a1f04052880eb9d0e4a17ade1642899488682a150d266b52515cf16c99648266d922849569b4d0a0583219f287d6d479d7790ec6f5b1a5ad2be5ef05de9173625978696b58d93414fdd8eba0a6f91e8750fa63a5a168bc45279a0d2ba843fd4b66a55f738b7b4a2f240a585884b1675a43a9c5b42871b80801d8074931996597d756a45a9819092228240709ccac0ad7de5657a8e6e2575224b5d5bac24be723a9d2ea7aba6da61afaa5453d66b7e2946bd2b1cf55166c6aea8c42e8b8f5dbea4cf2c45930f6ff76c18f58e1d756ff7dceecefbdee2bdddfbcc96f35b9e79e619e50f569e3fafe050e55d84e210103a33678893eb060e8ca9520ac95b00e02a85c7d87c18fdda8db8ebc623dce35a5dc70275051f4689e346f933ac32c23f676ec25f717d5006e2531260242c285af6a44564fb7e1bfeeabe871f3d3b343e3e345dfbe299afdff572e87aeb9de35fdf7b8785f56b2a73133ea93e5fa6c34838a9a80d4271d56434451f3dfbe8c3f7a1e58ebd5f1fbfd37a7de8e5bbbe7ee6c55a65feaccb7c8ce8b96ef0c1cad4b26a9ef02021c75b0c0439ae170411811760226bb4a7b2ce10a536a34090ac47f0ba1d55955653795909f8d027b17525da6c5651b25a2549545349249309c5201a0a05d901ee3f0ed41203df168bb5f106acf547976fde2718c4ad575fdd58cb19f83d5b9693177dfe0722cb0c8665d1073c8163a3ebefdb385557f7c9815d53b3fbbd24b5d86d2102674210ca2807e37a6977059e9ba0629472ac0c41244340085d0584ad311f783d0d1ebf87e598b4f8bcb4cfb93e2683b4cf2a8164e320faabb78a0661dfe6e5517f2d66fb1e0a44976fd9c31bb8da46fcd4d4c6fbd68f1e0b781e88d28e471ef0fba6760d7cb2ae0e10be923983af70dd208137e50204966c0d38006e504d3ac0c13a4d5f2b788c1e8bcf18c557e437b1f2dcb923dce35369e51c928f64cee083d976946c78e3b3859f5c3b4aaa7b7c507ef3dc39ac3c3245beadc26f039613116f060e2a539639cfb3a98a9eb88788722f3e8de5c7145d46397ef7bd9fc1efcacf614a7da60bcb89e37dbcc7217bf167ea33c5584e7adfc733bde9e788f69ececc55c499f90d70e066f2ff02cf514958a0cf3a6537fefcd4a4e2e7a1c2829072f65b9335095e64bcba3b3342fc4cf69420a6c86b254c93364ce559267f9a3964efcb16531134ab7fb7f9cc51b3cf1c7decf0c083c70f0f3cf81026e5ef28f2680c7f40ec9c8eb55d9db2cfd38cd688596de495076f3cfccf0fde78187fa0b5c2fa883f20fe0fd48ed699fc7610fe03bf8fcfb3bc7c14f659e6314cc99806fb28e7c3a123030f7192fc1d0aaf8fe10ff0c39c0e25bc98b94826157e4726b3fa500bd492a5440702145119ae4827101e107be7e9a4c9a464dd08f842c9a824b04f1cb8eda9a73ff2657c8cfda97debad3ffecf5b6ffdf1ef6b37e94b86a2361bfb7ce0d62f3e73db538bd967ed5bca3f456707b5f85dd6ae2be564aedb4ce159309d4c0c144a37f1bbb7feebbfde487bf6df1ff879da1134ddf8ecbfddfaafb5fffda7b718bc3e849fc79f3099b708dca9eaecb894002a3aac56e8d74664f685e2518b8d7dfe696fc989efef2d39819fbfeb8107eebaeb8107149cfe163f8f13ac3d7bcaa6f54713a4a15fe90ab21670e7be920fb1c781c8c5588e7f66ebf0b2734ab287394fdc439755a3dc86cf130ffdc4f263cab9dedcc5a7f3da61be0602c78632c86cf4304c89b6d298eae7896a73f44a9f92ebf1c7249efb642d2b6de3c54f838f9493492881e6542322b2f00496301c106e65747f503dd188e080ea73ef3509525518cd9c2f99487ae29e603014c2a65fbc7ee6311df7ee80f0794ee41e2193f24fe49ffccc201ce375fc6bf45df28ecc63b89ffc51890b510e12508ed91a07d57bd1ae280d3942c9ba762f4bde73712182cfe86127eaec9f4e4f928f4e6fc6bdc7f00000a6dffd7f3d9e4b8f705be61d0fc9c2ce0015d09dea301623b3144882c8e58f603f2b50cec8e0605d51114051455145690918c060349974525598a3efa6af0e853c4a3782a190eee7bff8f863c5173fc25f5dfc28be48bbd3505edea4f5a4ec58f96bec4b7b4959d76bead8f15d3209c50a6f9b1f8846a3d1444108369b2fe4897b92514994d0b7e8d1bb9f4eaf225ffee2c71f6b28c6aeaed7fe70ec0f3f6b2fd5c6a9b66b554e7a4225b77efe0b04cc8dd064a52fa11391e4bf84b7d97c9c997ef3d2b75d8a732fd2b795e086458fde4d879affe2d2aed7308421a50b80b20e5ec2bfe1f3e086ab9eb1590932fb85811ddd0c74351c95500414449c008123c290babf19876c761f76bc2147382027dfb3ae72c0981b5c7ea329e033eb24677e1e6aa994f8bc413a2e4b5249f39e4844f1811d073c4e7bada3aab633d4b8d222f710cee0a9b6079d81956df8fce625cb3b9d9e1a57b5cfee771d2b4777a0da1d6e62f45d6ecf7c0c3731592c04cb523d3e14b00479c18184e77ad9c137ec48460178c2545a1cc7e6b0e24b45b8757ebf3fe40f5578fc9e068f572f558731a886b15d46904463d428bfc3ef193c756d20440c5c6b3cd6c61948adffda535b72b224fefb34b96b60d7d47cc264fa41eef14b9b80c89fca9cc161265315412cd522094495cf4464029a983737743a005d91aec8a0070924a3e2c19db45081cd6344a3cf18958fe019f977a4675a9862621bd7766913dd17f0effc367306f7a9ef2953bcb68b24814383927a57afbc4dcfdea613798ebe6fd666a7587265a543814a88f452a5bc8b9cfc1bac3a770ecf9f3841969d384176cabf39770eab14e9718a0e965eaa0c98c9c837a8b2a48f94a6bf018022b9fae365cab935650af3e6e4a6cc19d2cd75838b617724b5130ca02f32e8c74de504459e101d95637520e9a4b1522c2eb6f7998d04a068b8a2ac842b2a52821d1c5c3f644f6b0217b86aaa9dd99d9c3636a3457251495cdbb749bea4c7a89d0ee651f775e8497a8468d247baf3b673f24f15f116f7aa05e9ed18f3ca9fc53b6ff7de4efceafe4efe4f3a72f9d7ecfb9be7957d9ebc11be43447c1142844f3f92790d990ca8483099d794b357e46f642e600a1ea15424fd0e9df1f8ee1390c95c9acc5cc056a5fce2a3744b81efa61f51ee3f42efefca5cc07eb21d7ce4eacc12e5fec57b2854f1dd74bfe2632c5f99b980e7c84cee59829f56fa750f1151ceebd7b139fdfa76e602b69366f0e1a5f4dfd87b2fb17e7d2d73017eab945f7c8bbdf7126b9bc34b9911e5bd973aa0116f26e310c24b170f4392fe4d37431239722c730200ca9f04f244f28978f849a2fccd64e447d4be7a691f696b2ce642b8e4ce5cc0a7992ecb058dd001ab71a9120de0aa46119d08621572820b79ce8a3ade829241ecad4101d73a16aaa1d76a0c296aa45411f29c6e1ed598bdaf180581e5f7f5f4814e278d96ea4b384972f781c190fd21f53b940ef5a8ede8e668cede7743a9aef7d986c1a01f2d45bddea1345782ecf9a1a154a0abb36991c70db0aca77375d7aa44745147537b6dc0dde869d0947465923d1c984f4927c40394b506e2822570197d74e13d99704c9977f79dab8f74447674e0cde8f5fafd5ef967f8dcbbcf313571a049d35cd36ff8e9aca67496baef5353b796e9a5b28f1c7f657a7aae765bc82a0301809757c076fc316963d4ce012e38a21c8de7050e911b0451e0c54109793d0abcb097e888ea56ed510df6eade56025190c47150ebe950ab86fd43297b7939307252ee28b75b2bb4d41d7e83640b439ef62ce0897b66ff96af8d46ebeaa2d13a2e969e21c14b9f29f8893737d7d647a3f5b5cdd294bcb3a5b63e12a9af6de998ca64e4aba0111f21e3e025573d0028b1d595a4745359296c5dd33a2795f545ef2247aeced015acd3d65526237f3c33821d6c9da6d5f59ba6eb57fe6ae6222e633421937e839567be45e5a3bfb11c3df7ab914d022fdca2841f109edbaf43a2474d1675407f1ecf3048aeb0cf4785b3a422ca782c1e7a9d9b1e95bf80e736cbe5a47dcff41e1c92776ec2bfa6bf76cfd48d53e7ce4ddd3845e5eb8732af131df93238e0df14796511141715155f0902cf0b937a449d96379e139117387edc8094fc979690a222273b62a29625062c2e2abe85f6f9d6f7f14c2a92ab4e0b0d085804fb0b9ee4796e309b259ee3d70d0da5ec6ce873194b19632c1e6ddc520e160a3c882ebda387fcead22f7bb85d970e6c3cb8317d3f7928957673553d971ee6ce0c1ed842225bf76cddb3f5e449f6e7d65b15da7d9af2058a2782b239c71758f9d682f24bdfa3e55b3317f1874a79fae70aadff095d27bd998bf87b463f2b1905dd92da58853c87bd208200228b2653088da42b22bc41e2c7732487d199624a67f4fd94a82884842e090a058da0942c4450e842c0442249e1524834eee10a2c00e44d3989df499fb7fb05bc983effd64206810b53f2b7f57edb14330cf86b182dc8642ede0383c4c811f011e1e23500102242fa1178080911fe030acf3b4d7f035a4925390975108368aa391a6959a468b60d74e71a0bd7073c6e9460756e4f3dcab6a3ebed763f27558503ef53b72d4a79e4543eb8a3cbbf99ec6f1b0d8e6615dda3a1dd4cd1ddb5a3abc25b2415792bf0891ced6c4b0d45bb0562f11ef032adf7be7d4cebed3de0655aefa1d490734b28b8a57a5b8e685258a4ffa6ea92290c66324f2021ba2c6f7f8201814b9f57f5c201a88345b03db51550d2497485f70650b74687b8baac98086ceb2cc0b801753a7b5f796909278ad270919e48523652265cdfd850bf28bca8ae3614544ecbd41686c3485786aa68b60483218f2a78152ad33d894412a3499fc72379e2f8aba1f1f1a10f3f64951f9ead4d776ff76075d543d75fba8fca5d3af9675e5c467ea86aab4f781f3c9a55b33ff3cc9173eef4d7cf9fff8a325645971b8524f4c09da9d33c1a8a8a90d7935e404b85052b4e42b90da1a81c26c002157a4bc5b8d948f43a91f044cf8ce41ce1f62ae267a5d5c4190c45c3658c9830c71a87d0dfda8ad0dad3da93eaeeea686f4b26e2b148736343b89ec2247788a8423accc6f25249842846aba49c3adb52b80d590052aa7a3b9af445258fb2d292aab27b6547e78a9cb25b9217cf07be0f4bc2de2dcb2f1dbbdd7bfbafbdf84479d7b66ddd65e4459fffdee452229095c94fb1fdcb541e240f0dec9a927bce9f0f1d0b31582a7a66455edf9bda5d8165008632a002bb4e1208873a6e5c49013d56824545f63e8bd9c8e9f586e1d262623038995b9683eff7fb01e8b62c18b8acd06e55847663946e81e687895105471c5f39774e7e53be7fbe8187d2e795316fe5bae96e05fd7943447bfa7b4c60e7d3e701f036663f0a4118927030b51f4a2bb0cc545a3651ae1a93cc940b9aac680693999dbd6befb318f3ac4a4e665572407f43436dadd3d9906c48449a6bc3b5e1fa3a67c819f4bae7b132d92457d873192b933f5fb890a2c97c6143ee5fc0fc44ceaa22477aca7bbb175f557f1558a47eaf89210de7cfcb8f6972083058642ee227182c3c1080383cac08b9f17c3854140e1c4a4b85e13289509880d90cc3165331a72633697b9fcf152ba1fb66b369d4822693c3d43f94aa0f05bdde45e1603c14f306bc01bfcfedca9b2d39305adf038c019f39199504299acc37cf4ae2e580f8c51dcd8edb1c693705e11d0e578bafc6518362a3bb008c511c3f75eac6f3e7e546a7cdb7c859a9172867a2ebe522b193372006adb002af50b61bcb008880701408dd14e3b8b98808061d112d82380e1c573a6c339573a5a5f6be8a62c2f325c3c6325252e2ecab448b451ab652e2eb90b4d39f96d3b6080827ff6f1ba3a85d7db9c6ca91b6021c671ab5a1c9e4ba7c83a9beff8bb6f428490ed6a27514ac5687b57f686828d5dcde06d0d3ddb6a27d796b3211a7dcbba5b96951219da5d320eaf7182d552c5692f9055a7ca1dce94c169fc593a703983555128928252718d172bad049827bae4a1dd97e05d7ddb564652ab5529f01ef1527e6a132270ca7ae48efa7cce9e6ac659fbc91185b3a7c15d9faed6424924cb4c47479b3e6996786c6e5daf3f2939abd9fadbb9fab311a6168623cebead4317ba5b5942716b3b18c674278915ee2e85aa9715457396d25125d4ac5069dc8d3c554612a57d65c2cd6dcdcd0104bc692897873940a3a0d4d0d4d8b1ae7e74b050bc935ef429abba0b4cb9c57413e540896ff9c0754ffa999c7b779ffd3bb2dcf4c9e071bc55c7e9e516600f1e26d2a5c3a60312c8595b016d6c13fa53e1df47bdd352691543baa6c16b3c8e0535652a497040aa186503850efabf3d4ba8c45144ae5a5144e228593d35e69ad1018a47a7b57ad5ab62c95eaecec5dd7bbaebf6fd5da556bd7ac5eb672d9ca15cb534b534b97f4742eee5ccc983b9d7ab16864eecc5b00928d9785e4c2109d0fb2da75a9a2109aefcefabbe03d05e2ef32886b7ff3215f00fd7c0c2858d0bda3c50f51d86f8441d80ec33006e3f04aeafb91e6450df5b541ab8e047c1e17c5868ee1c26c64d8902836daa36d2dad4dc9c644385e170b59ca28462a4c0a4e74142714974e7ba5c4b0b267cfc8c88e1d5bb76eda3430d0dbbb677ccff8bebd23632363bb47770cef18deb573ebf6addbb70d6d1adc34b865f3c0c6818d1bd62bd85bbb66f5aa952b962f5bbaa427b5b8bbabf3efc05ac77c58f37c70ecd1cd600e8bf337f0ce0bdbddeb477c6347dddbdcb7bbb7b99157bfc8d9bf73d12ae7feba4fbbb7bbe534fdd2e23deddbe645ceb78d7d49ab7fe579113d07d9b311ce90ce642296bbd7a14a7dfb52636026681ea40cdd5452c465dd6bec7d1a5f2fd3899ccad8733f54b1c8eda6c077fbdc3eaf6701f05bde8b8f7be21ec6c53d71cf829c9bfb84bc0abf7ce95eefed5ef21afd5ac0b1df3db6e5fc79f94d66e723e9f399ab8897e55ef0c0fa547f5931e14b388284c7712805288531ca93ec7dc6f2224e10c461cac84425e114e9afa9a9f1d4781610494c4ca56e9e87d7a8c3f1a0144d12efa507db66e3987d0f90b72f4d7b6ff79ec2d433790378197fa3d04572f140e62afc05b79af18b6da941405192b09490de304a6b161c497911993310ba38f297853688ca828ddc6507a37817311efa8ba1fdfb87debd6d8161f1b1fd8f9e7d7719e596dfb3de39feef7befb01c9d35c4bd9ff9f7bb5e0ea5bf799eedf1d36f64ce101db327e8d28f00a04484ccf7e04665777f23ddd8a24c3267f0b72c1e26966a015ee06fa17bb75b99a70e0ab09f79180daaea0d24ebd41074fa4f921c9a1a87f36194c3dfa6b7b4909f5eba387a23eebaf16a94a76e3a2a5fe21e979f637aabff87ef79f7ed66a1ebddfb476fc49db3de231b3267f0bfb86e28055fca5d62904481e72425901ee11682c872f79742a991936c6101a3126695b89c6cc09d37f590849c42af2fdce09597feed08f7f817e477a65f397e6b992896bf9cde9bc9c84d9933f803ae1bbc44f745008ee8d26fb379f566e60cfe98f99995c192d4621141421864a9f93889ec070e78e4f87166811b16740401d7f13c005fc66b27284aa2001c704683d239cc1ff7c53771e78df26784436953335773e9cbcaf08f708f5fda440e656190c95ce23367f01f15bc5f7c0140c33f10a2bb0d002f793357e1adcc6edea6c8c4a58a2b01ec0142ec84657f22486ec9773018a1f707f22ce6cc5e6ef4e0adef3e40a64e90a953939c74e91d00c0f41b59df9ff9fd85a4cab0e2f373f105bef5d424f38998cedc81bfe29a4084aa9455e408a73a6928be7ae672225686051f173747117f75e8d2a57df29b5378f63afcb8dca8fadddd813fcd3e2fcc7adec49eb7f910a366cb7f1dc21af9cd7d97a6f0657c453e7c9d9af7f318b1927f6159d2da5209b7a3c2c873c46590800a90bdc011201c9c54ce3e3cc1cc91836aa60b82eb2aaa2acccc4d26c1d2f748169f9a2a22198f5aac2c894f48aab0da8ca268c14d2e3f99e6c6b7f6ec3a7c7857cfd6716e9af85d1fad0d066bc7750ddeff9fbd3f816fabba12c7f173eedbb4dada6559f222c9926ccbab6459defd9c046777ec6cb69390e044591cb23b842e387581c0b474681a6886420bb474a14917284b976967faa7746a18665a96322d0db49d329dceb4cc30b44d4b1c3ffd3ff7bea7cd713686dffce6fbfdfc02b265e9dd7bcf3df7dcb3dd7bce59bb4b7feb2dba894d1fde34a1bbe556fdaeb5813add7fd52574b84997b9bf530b5b483d196736f742592e71d92c3c414fb111798e18743ce181e356b8ad0497e939024b79769009dbb5fb76d847067c3e2d4e58d07ba220b9a5082bce1e4986132dc948d2cdeab3bb259753cc8f0afa63c570ef70aca3bb61b8777d65e5fade91c6ce9ea675bdebca8ef7f777742c5edc81a1a6e1dee1b2b2e1def58d724f7c7def88af625defba58c70717b3ef3b58ac6d7bfa30a9e05643106a2106b2dc1d29773b788e34872bcc3c725113bb5fc351a423e1b4025970945576a148a794446095c3a722bd4ac37724ee70b91391ccbd6186f79670c4e976b99ce0722744d1696f550bec928ac35b7bc7f6e3645d75f1bacdbb36f46ede839335a1e0fa6b95cf57f903c15b6b1beb22e2fe037405f60c879a753fe65d8786d6edd11fd82f5dbfbeaa517ad6e03eb0061371d3d7a5ba80d22f364542ad46c2e2883f4b46c82be0835a6880634f3a44a2d7650a59d7890802220fb81378d0e979ddb809f584e847c10806a3611b8784485b4092daa845583fefe3c019f5dcb8d60032cf938151d955564695b5b2dab29ab0d5ea0c5559ad16b3be228a7167f0c2d24311b53e6c61052237dd97868181f791bcf2ddfcce9d938fb6b43435c7e24dffaab4e13439b656318eb7761fd812bcee4077eb2df56bd7cd3e9d8c95c45a13f19278ebb94dea3da7a2f45996abb81e86e5b520889c287053600223988c5312723a64274a7a4403820959922496fba86d25188de6b122349b7bcd0300500f75b535911095b3567bc05665b55a1dc5fa0a16fa9f2d75ab46851724cc8a1714cefff39ece850b172eecdcbb78d7cdfc51d2154b74df77df7df77525e25de4a8f0c1714332b5c0b566648d6b412a7978acb1b523cefe8a77b4366e9dd0f21285d9dac6e5260189a81df0f07c2ece5fdaa24349ea66d9967de0b5dafd41abddafd797459d6ab66515d26c5660679025f6b8a12ef6a94f6d58f9c5fb3dbe975f6edfa1a6585ebb6fc3b2f57b4b3d1bf6290fa9c99511166ab9346aa984e15047b91c2f10812753221209514721d22a81f7acd4a34e9749210100b5506b75076cd55697d5a02f8f869cfe04dd119160221e8e6403eb332874b8dc2e3743a12811b7d2d2d04b0e2e34a1cfd7bebb7fe7fb850f908ec6e63687abc4f8f90fad6c9b98b8ddd7ef375a761563555b4ddb486cdfa69a9658ad37ec2bb7efb275ac76a834110120cd2c2779935caf697e1635ed0b472cda6dc16cf0841a3e68f5685913125222c95e5aa66aa7445fb8f3c817bef0852f3c4b0ccfd2df9facdb5ab7a67e5bdde313131fafdf56b7ba6e6b3dbb9ba8d63e704105c59c494f3841253f115142d051f2331858829ab69546d4e9da7503256e80729fbba2a48215a2b356f9a93e62d69747e319aad3322707555acba64ddeba72e5ca95bfd877f307f76ffbc94fb625baba12db3eb4bd7f17f61c1a1b3bd4bffd43f8c9f658ace39fb4dcad5abeec52e8913bdd087a5ca1a3ba031535bb442402723ce1761a0d44af674941d41429a5509ae7f835d18dce65883e98812f936f8af47de9f6471f7df4e8a38f3e7afb97b4bc53e415e58e6b7bafddb3e7dade6bf10635fd1485a70c64d24d8e830792724bdedd687634c88aad0c8320c016ca9e601542898b2ea2d968d081073d92e88a86b22553fc4e3fa573bfd34f37a2df89a6febd5ddd7bafc166e587f105f87965c3823836cb6ddb171e5fb0a36def82b86d972dbe602f8b871a84ad444762da3dc53ab986ee418e2e140744e0c84e76e84961a1cb26f0991b8ba27a63d1aabd888eda329917be32a2fc8efeafe27e1915252c070bed3f13e577f17bdc5aff7e7f22fb1f016519b62bff8e2ee507f8e4be97f67d7b9f1acf55967e9a747326f04200aae1a3b2a11a39524485f48ae58f180747e4269044513ac0ce557528ea115084dd260341236a48e77976219d4e90e35779e5469044e9d895b760e5d9aa82be802f505166b556db28095bcc6245143503c4e90f44a46032b360cc3811928c3bb929f77493ee530d3f9969c01ae5870e7d7455b3bcb47bef359b6b57d5deaafcb4a12dbe60d3265f0909debeebf6bd3879a767cf52ba9037ddf487dbd95a56b614339d25830bbaffbae4f60aa464ce32d70bbc90b10578aaa9f3303ed718f05badd556bfd5a213cba318e4fc4e7ff002883509e626dddfde886ee5054be7aadefea1ae7d4b9677aceab85bf9a3bfadd75f816f28670f9ffea6fbc040ddc29deda74f1fee4a54875b4d2c47d1265246dad4dca8bec111d9c9d38d57709bb41306bc72a97a1132efdbdc7dd26c6e54bd2f1a0a26b229e670f74d8f3d76d3638f6d7afcf0e38f1fbef08e3b208cc204a6492918417c4c428c454352842a6249b7e49630fac1a1a9a9a10fdebcfa969b57fff1e6d553370f7df096d5b74cad56e92c05fdf80732c8e21719760145a41b65bc60a38cd28d3226212ff043761b42a9db56612fcfc52dea2e08c2a2dac09cb8bd8aca48a4b2b432525df96de5001eff7165a4baa2b4a2baba129fab2a2b0f85cacb83df3ca83c55559ebd784260b576778acb465b68bb981046aa74953992bd109fd9bb6aac038b77b86342b967428d93483f4d44ce0476965b8b55bfa6b463d01349444009760ac8a8275bb25f3526ed90639546b1e0629b6a97dbe31c11bf50f7e2b925ab965c7fa2ee6f7ebd69d52654266e9e78e185899b27b048794bcda5066dc444368201c4c77480b168327764bd227714dd967fc43cb71d47db85428990531025975b202645c6ef2aafd1c618687b71f2c589db2c3ac9721b10960bd3447ec2f2ca06e40a6a22f254362cbb803779a98993070d45611e44748827725029ef99606b33b77f89e7c825facf839a2d5101e46c881cf4cacb5a6c4b0ff381764031ac52f560af809ca84a5fe0f9ce95525e5a31afeca58ceb58ee91fc6f47656336ffb1be9ce51ecb6d33679c389586afb0adf6f0e627261e7f7ce209bad762b08184490784c94d904289dc0490de0fd647011f493d92883e8af88df47e76ce8f1083df9230be0c5e187b544c2d52efd89412046a07f2e3801cc702aeb8ad0272c8adf6cad5acee23e1a7807e22b01c2cdab342e659a08f8eca7a5b55d05615a0ece1511116d9554ddce58ac7d89d0896ee83aa5e4e673036d897e829b7fbece1ddab1664dee140f7f2fa1a8f27ba7455e60d85b91ffe9ad8f03110a04785d7a5e675cedd4e57ad542f8b20c9bfb5ae7e3efa84d56ae1f41ecab3e8a2dadeaf7c159ffbeb6354e75d846b8983e5828bb0381e9f84596f0a4b4b7844adfe6b65d57fd9acecf14cfdda455f7ecfe91b36164bfaa22db8166f53def7ebaa40a0ead7946795c283a407df6691ce4958262f6ea970eb798167b9fa5882467edc20e93981106194bd11c8988e23442043b5b53e1f406db2b6b5b9510d85062f94864221a3e889b6e62c9cd66cc473805d2f09f69064246bea54660c1f5172bbe2db960ef5b52cb309baae78f7d2a1bef872f6b63162211e67d4e7f7fbd0e5f7fb2ae9dff6fa0757f45cd3bdb2a5b1a623fb66796595bebf2950764d5980fd607fb2dac931ad7672c5a57047b72ec59b9acfb1e66b9f7eec833bec46932d15c33f2826f4c57b7a62caafe6f697adc54ce018351d8f642b31d3fe0a2b31b3de3ef035dadfebb4372c534c9a4d1364779daae510d5f5802a1154a60d33b1c72307dc2a96aedfee0ff905d1933164b2464cc68051cd17cd78213e66baccfe8c192e08bef40a56dfce0855b29fc7396428504b1ff3f42bb796f4d11ab4065f392a934af9e8671fe74a0f1f3eff6bae94f6974caf203e5643ad4eae31e8798eca39e6f1993792cf6e75587891b9ad10137ea7dfeee4fcc4a778b15af909fe8bf2d89fdec6558fdf8083b8f68609e5b7ef577e0308c500641dcb25d924d74bc8aa191024c7184e6e9bcb20d5d80fb7d54a7114e7a848a13448f1ff6f376cff9b61339148d1f1f7bf844f1e503e196f49c44889560f2e5373c60555d02ac7adecae19b54ec8312d13de38f000fc28a8097a78180216e849ad925058144b5876a4bc9a3394fa132de10816169ce91a7dffe49e9defd93adcbb5c207fb507b996587322d1dc9c20afb43d32fecd1d878fa496a60eb6c503839f9395de5cc91956bf7c05ab5fae456c64728876aed4f81bd7cb728876f35ad5eea03dc0aa76b31ca217a6105d3e998ac5260f6eeb59c932885a5906d1eb932d38a23cbc7d424d200a00427a363d4336929f33ddc6cb6ea09d508fdea346140c88a280e320824e2feac6197a3804685b6946bd9e8c9924b5d0b6578ecff334b3d8b594472cff613b6b268d995092daa58151394c398dcd0650db521b6f6a50798dcd6b2b753ba9dac4522f14e9e7e609e38218e7138916cac71df1797288a95f6c5ebd5a968786e4c51b08bf41b9a9bdbdfd6783837d7d43437d65bef6f6763cbeb4a7af6729fba11c20afcc76f47574f47528e5cbbafbba97b11f03de72fa593b8098fe4d7a868c6878aa8076b806d6c0234fb6d610a321530cbaa91885221507128a26341845c3ee3c9cd9cc56ce682463163de5b30c6d6df3369907716a5bdd98454f98e53c2ac7fafb3b3ad4e4a6fd6bfa57af5cde714dc722b9a7b2bdb2ad25561fcd243bcde2d13e3f1e0bbc53d9efdd05ac5dcce2953e162fccc7bb68d1a28ef66bae695fbb00bfb04079bab4d4edf67addb7f4f634d7d48b62634d537777534d832836d4547a9adc258d7f5352e22e292d2dc16ff424fa123dbd2d7d2dbdca12f2ca2fbdee3efa799fdbab90cef850ac2be4f586ba629937a5254331ed451f1b2a2905d596de4fbac91838212a57cfc39dd4281604878dd9cf4e740af3d9cfaaed5cbc645f57d7be25d8a0bcd4d6dbdb8675fbdb772ea4960cb563125d8701c10bfb49271b8ff2f36c38dc85fcdc090e8d9f27137e0d65fe843f9b28da8b75740ce5256c5047ddaf0da20d496d3a00d24dbe0eceb99e021141e0e002f31cc153e276e5663aafa780cdd4e94ff8f3679b94e96c67ff9358bf5f38e3ef1f56ab33934e0d0e56e124336b113901b80be00006878601e9a218a0d60fc3829ccc61e1570c8842547cffb09a6722a33b12aa6116f851627223f0c0119edb99834e10d89a085b44144058c592de963077a1247a3330f913feb9709561737c81b2013fbf20aefc109bafd9dbddb5b75fceba4eda762c38be707b1b203c88cdf80db2548d5a653407db7864f2161187b2c54e8c3e35c35dc65a5d3b984a0da652d83cde3f3ede3fd797e1876af8c8139528b02af3c6c111b959b5c3a83c3c98090f30e9896040e64d31b2e38751238ba3228cdebd7253a60dde76654d9823235c150cf8fc3e3ff36258add60873646410937fca4a11e64ec6392a0525775062f2ef317959d7defeeb6a57d5defc70c3cb6f37283fc5669b3ebaaad2e7a9d9fcc1868ef80272b464ef528abe9b6edaab7a3494e3367f4bf1915db72f8817ea316139c8accb6373a267e7788a58cc7fa63c4bd7ec2f7a264f4df63c7e982ba59accf95f43a686147905089ed5f295fc2b1ec74fa8f94ae0bf40472d95ff62e7b5f006005ecf72accdc96521964459cd90eb8f1e25afa8e744b702e04bec59099cb28da3dae7310aeb11666a8bacb650dc4e5be1e4e48bac4a6d9835e6a1257d9654a102462d5b5ebfbc9020e8391d873ce038d5d0057e54449e17c68c9281137861a8aaaaa4c46402c825cb2bf799dc2697cdc262404c22e3f0d96b989579efe1229fef6a69a9a989b754a399be6969a9513e3df7135c128bd0df9158e6b7f295b99f68f9e5d34f933067821ef877b9bc1925530b1aa500ea8c88669d1f5124c8a30d059034c25e0506bde11898f492d174087446b3a43b0428f280874000c20b8798f301f42019f5d238188bd02c1acdbb41041e447e1c40202c970cd90a04c86aafbc26dba3de244dd12e8d3af314ed53446a410201814c5d5daf6aa0450f7477b42713f15863bd2d14b405ec415bb858ac8842449a6b624a41bb6a64323cbb93199b5393adf638977b1c0f547a0c36a797d9a18ddda5cb575da73386770ff6794acb8a9da576fab6bacdbd61d5412b7b0457152f3796784b98891af6bf8aa53c7b2badb57bddec9dcf8392f244317bafd2a937fd34e9d47c83ed726b69a6ba9ca8f107cd2b2ba94c41ca630a212b630411e6189cc306ecfe843f9e8c732de108c776ff6773eec06f6f54fe0d1b2c9dab42fe8a117f5b2f59937309a2e1f069e540386156d25d09c8af75204111952f452cdf225193ef218fb7096af44ba6f62647258cd1a0c5a38aa2278ac93817e4e26e5b321974bbdcf891da0f7ce3d3df9c8aee2d8e1e8f161791ca6fd17df72de5be85dffeeb452c15001800c800791d6c94771bf492c853534f87b04c35f7809023f6521b477b6f490645494a840467241c09ba9cd620a4058335fc4d0ca2ff5b618b41d84074e15a57e5fde37f3a3b7e7fa5b3363cfb6736c60a12257bc046758562cd96244b29515d60dd58ad8be858c908b62623623098f0b3526d1151baa613c7dce5188a7e0f1f1814258f67450fa6dc6e491850c67e108d12bf8776500d41d2410e828d62d088482c068e0324541d59a1556d9cc7a8b239fd559451855a930991494177221c89c4ed5c10efb0fa6aca94277199afd6edfaccf86ffe75f79b2eb79e1f14f40eef73ca4f959faa785caecd312e379991ae1701b242af23ccc545d927214c0e932d1cfd8aca611bd8cafccc864ed0fd118e6012b5c815eb47be170d61b95b79a01bb7793c926e70f98020b9dd98ea55eef5f8496df407ca181d377d960cb0faed3ed963b312e4b317121807b6db9d149f4228e176b9e3acd480438ab726934e8c2a3f57178dada1415b31a29bfdb3ba868c3eea61391920bbc1068d729dc0fc0a063d8165ccb990e73fe7320e501bd896ab7e01ab167e836e142537b5e2eb0725c9e3c16d5dca03ea4a2ec7077e10ad237e0feee8563ec916b3600d3be4a48808451272048b91239cead560a9116e9be72e8f0d6cfe908d6a9b213564dfa92a7c11a7cbed46cb6ffe75f767acbe5a1f2e539e2cab71bb3e13c40846fe21b79c7973a66b592fd79a29edb09af8362b81a5749f5e30b0dfeaa248b6872392cbe90c5afdce6080b24394bec2960e1f501713c7baf15175f1f001b69cca277b99cc483f0241f23039087e5828cb6691209495d84c128a3c96bb09c7732b58a8d33151a07fdc06c8f30c02ca08903202f0833f6173266c4ca9cb4d3e47c84995b6555cdc9345c5ae2c597f8611fa3d73109325f3dc5f8ceed69301ee56f053fdc45f69b7598a09ea28ed591197b18c66c7a8b977c459ea74328f4896022f60231982ac535e6304a9f2940006727f9dca50e79139fce5c3395a4dff4e7996ec49ffe545ef03895afea03db353644a79f67bd936503daf9e837e6bf661dc0cb931e0f8e59fffbbbce771f3659f2777e73ffff6659fe71af39e27775ffef96733cf3f43f6708d977bfe197e475effdc5b97ed5fa8d69ec7cd57f03c6ecece976bd49ebf8235e31a336b06cb2f3206d532976fd9a201a43e7bf212cf9e3c75aae0d9e94b3c3b7de64cfeb358778979629df25d94f3f0a23c8b375eeaf91b951bf08ec2e79fbad4f34f29b5f872c1f3c47c29bc9b677f4efcd9e7b1eeb2eb5a003f315feaf9a0354ef66490aee1e612349fc18d46032a2c97dc2305cf531abb02dce7d19806bb006ed94175816354f5501b592d4cb5f15b35da44599df5ec1ea633e6d13fcb85a48d463532969c8790ac8dc346ce8d2e676038f793ab8583f640a694efe6e098db5673d9e27c7350becb5a3f4beecee2f7b27b321f5f247df6aae7acadd0779567b1f55273be14dc19dc67e08e4157ba895ccbfd50bb97510c767067efba676270f2634ef2e31eae87fd300137c2fbe103700bdc0e77c047e12ef82bb80f1e8087e00b701abe0a8fc1d7e1afe16fe17bf003007b301177cf7909ff077ef69eaeaeecff937d7d8af77fd7dfdc471666fefd6d4fe6dfb7dee577904acf286ffc8fd1cedfc30fe145f827f819fc1c7e05ff0abf85ff84dfc39f6006d2c8a184462c463bbad18b1548f5aa2836621c93d889bdb81017e3725c856b701837e216dc863bf17adc8f137823be1f3f80b7e0ed78077ef4ff1efafcbfe5b339fb6cf6e77d7df91f299bfebfef2ff57d1e1ff86076dfdef47fccbb0f66a1875096d7b44337f4c135b01456c210ac8351b816c6603b8cc35e380837c07b61123e08c7e043f097f031f8387c023e059f86cfc117e1cbf0283c01df84efc0ff0fbe0fcf00a076bff272af6062cecbea8e2782f92fe1ffe5bfc9b57d17feebd2fed1773992b9e77fe60fee870b7b2ef8975d4ffe9f33f4f8adffe66fa6ff9c8518d94aee8762a6ffe4c7061543519813ddd1d682426362f0c7770e0ddd393ccc7ec6b2ef86ef1ccaebef01e0407c8c438c45f51cd9aa3ccb14af5dec6792f9129b586dc51f81046ea894cbdc0e7bb1c928891c31cc13f0e267012f76a7d49a4c26222cfdb7e474bbdc78cb174f9dfae2e7ce394b06074b9c82dbb56a95cb8dad5bb14d99beeedf9beb3e3ef8f1682c5efbf1c18fd7c601e11cd3e3ef0541cd6f3e8fa324a3f205ad7ea71f37a7f090f296f22cf9518af95dce61aba62bd2f69a7e5c108a915319e34ce9bc338545d84abecdc2ce98ddb13937fe3c3790f2c64fd0f195b7f010053aa5d915adb8393bbea6abcef14e6ae3b3249e9b953bb12885ad293252a0eb1aa1460e433679278f0c8aec8958c10514d432826a0851dea23d1631acec499191d98753f97e817b4162676c73e6c6653a559572d6a5d61d99d2facaf4a3e15802bf5c7e41f4555e1a6951bb2019d774f322866a061264d69af55325fbd5b5028e02954df24811900f53668adaf484eafcb931bcab7dcd93f434b378f9f3a3f857d1a5a12a6f7e78ef95f913b035a5cee52edcccd75cd4b6a5402b770927f3613542831c55ef143358b3a7ae17c05cb0d0897cc833d0eb52a914fe21636f3ec3ec7eaab556cb2181e580bde01ed39cbeedacccb47ac4762e75ea546a3a95529e217b5364e4dc3f0935597f02de0b7a08c815dcdcfef82c555a04d1abf6e7b706adc173a953f8724a45f16c13d9935b2f5440cfcefe2f377dd6636ef6b9b91fd2a60e001cebf37b6cefe9a1186272a376e6899ca87aeb25849dec1afb304892b80544495c95bda2299647edfe0c063806fd39e5ad53a96752a96752a7b008ef4de11fcefd44a83ef713a24b51567232eb5fb9d899a53d98412843e7b3b4b150adf904f02954589ee65a39c221f0149b80028b89b968ac00c3810a1d3e9552eec497a729708c019e7f8e0246d2bf8317185c5adf02129e1ccba5e1bf589c4088d115c770c05c438752d314012fa4287364d4a5f237dab71e8272a58adf7937bfb664d90365dae1294aa3eaf62723b42f7881ed039506788e3f76a96006d661288ffe29e764e4ff4296f6733c45dd5b2a4f111866595c0af082c04a2a0b5be8c62bc42ce32ece3c0e93e1328a49253175cd35bf06db5b1c22cfd69c8e71d1be3364608d3b832a29a81e434a0c8c831138092f68728bad198f28e03110802302bb87314784e5d68c42cd586c9cec516a99344c9d7a81ab9c5d9422238a290b7301ad516811088fe41218b13309cb7e06cfa5f065e5cee914169d529ee55ad83e4eb13d97077731c57966cf218757b8df982466b360fb4d9d0163ad74c7515a514cca4b0cff9c26c314e0d81e67e74984538f0079849d22f21cc70f8bc8f3dc1609399e2b18909518609b479d96e679be936e213639bc57dd45b377abf3cb5b6f8da7a82b2e20488c651191273b41942471184451da02922815ce31989921a761529be12915999402e80ccf3fa7aa10eafed5e678f91804312f06814d46439c8a303ecf7766042bb8a147ee5457488fa224dea6ae930e59163849d20d1b50a793b61851d24917e40f162b0a562c1f91792b5788cdcc02e663353b474d46d5ca919c1621e08597b1e6c44ac5add9f93295224b2b593ac9e928577cf705f3efbeec999da2ccea54965bcd3e9cf1e3b275d160ce233c6074c7c22b851cd96561cee8531ac1bda5695519724b15e8675778efd89e77eff88fa9cf91a9cfa5f6a96a1ac533209cd470c07835d3ef8ef1c85809d523f37889c6ab73d3576ad5d953c6af6a6b8cb76a6ba6f26b4db7d6d82a009b3ded942fe0d719aeaa69da94a9bec055528e9ae3d7393d805719d37cd161057a40964f539a53d934d792d2fa3ca9e131a3a7141a116a984dbe9ea2e190f2b8534c4ff9918ac17c7ddcaec6d1524d4ac75646e4095b1bfa9be016bdc456273f752add2e9a52a5fe9719e614a3ad53a9545641674afa3c63b6c9899c7e984dd8ba53a75a033a660de8719e81f335c53c6c6535c69c74d3344755c421007491918b9c0586fc56bf0a6d1793df67a18bd9a5048a651361f18970c482a23b1af223d93a7b37b67691ad2aed8066c312101f23d4e644b492adb3f753839368e713aa5d7a3710aa4d21c252ca0f8e30b14b88e88aea896aa692bb67efd7fa4d9f856632a2f58b40fbb5221999bd9fdc3f7b97569724fd3be4c91e7cee0af5797e9ad51f549ec5e39738abc2e3d3d3ea591507216c2571662f5c498d0ab673733ec0878e1e9d66ff636b8afe63fdf124cee0fd6ff5c74fd37fc0c1b9b41737730f6afd51bb72ee698c408ea974c6f347e6a844da8baa44f41f19c0d5b36e1c9875e110ed613aedc5e3ffddbe8f3348a7c906ec9f35e1f65903f65356e0c156d29bc56b3144e42a49e038860af606618bc8132e8b8cacc82d74b34ea6522733ff6b584ea558ff3ce9cde2f95dea5fc5faf434f0702e1dc7cddca7b2fdb7caf10ba382819704fe925a12065922fbec2bb314a914f9bb7f9afdb3f63ff0309d8ee3f1ff07c6d396677aba60bc0cef7eeeaa7d351c99566b7c2acf5c85af2788c7a7f1b3679467c8f3aa8ca77bf42ac6cfb4d786cfdaa0cf5dbdafa7633adbfef815fa8aa8bd767cfa0c7e36e32acab6bfb2f1f3db774c6bf298f2b5abf775f1e43f665f61f8bb2a5f19550b364d9fc9b8ca587b3afeefafb23d4ffe73f6a719befc4e7c65fc3409677d6dc7afb03dd5578f2b9bce4c675c6ddaf857df5e1bfe5df25fbd320db9bdc074abb01ca49aa5e61352d3dd731cf3af7085de10d5a2a4fdc5a6cf286f29cf90bdcc52cfee0dd65f44ae52e746952b6abdf0aaadaeddfacaef9099616ca3c4525874866a42aafb30d7dfd5cc35cebad26ebc14c0f5fbffd63c9f251d9486a6dfbd79728484e952bc1bf3a42bfa6ef8233b3274a6f2892c6dccd7d71cdf1beb8d4e32c8fa3b331dcb689a8b0afba3389bdfc77c81eb2da83a1918132a4a653b7c38afbf2b9f6b30a17575663aeb797db7e649f1366b7b17e7c93a7ce55d98a7baa2193befb97cdfa79aa34b4bba7651df674e8b3fa429ef1dd3b17cdbf1b97c3b8a9a0aac4615af667e98cf8e4ae4bcfc58a49da4ec49c5a673f7fcf0b977765ef00ce99c9e7e27e70ecca6a53823536732a70eef1467b42f75779ec1cfd2fda921ec9de22b988867a03bc374090d59b9feded11c3ba6a7b3764ad6be56e51147e511073c70ac70c33c0ed6ecc18f2a1da961474594ca6fe7f4c9f1dc31e009b5d533a15d17daec98b51ba9c6a0998bc8c7e8767f25bfcf7776fec3abdbe9eacf91b2ae9933540b4965fd12ef106ff10ce7a652fdcc992cd2de29ce821900954d67288419845d35be0ae699c156e1d960831ccde73f3c25341e04e4859d173f379af780b023432ad97bbdd9fee9bc55bf3611d4c90beae4e7f39dcfdfbf7a7ea42a39effad9a6ca97a7e7e2e62afb8e67950b2a40ce149e9abee37e831acc414d8ea8dad4bbd16f966ca950397366de335eee2d350fc0256944ccf56eb54862593e3a82ce8c443d732696a392d87c63b0fca86c0c814361387b8423086c0c61ee18c1cc4e5135ba3319a477c4b2a4523046b3dc50801f814e4400110571e71c199e3f0893e259e65f4407cad24cbecccc8ec1ce9f4084db04e488a84a0251abee48ad644ebc608c7822a7499e3913cbae722c4398ef325d6629fe5da6cb79fbbd7a1e331f71be5bfce5c2bedf15de725190553a24d08fadc4a6f9a02e51c7969df5aa97fc7efde08358f7e083ccd1a4f6b11c7962d6fc4c57d6c72f4f9ec4ba9327993349ede35c7a0837731357729fbac063875fc1efcfbc863fa07d4ca7b7e3716ac05c791fccf5834fe1a7ce8bf800e4ddcf31676ce6db58fe9e51ba0863b4c721873fc8a2ef58ba336730208a4e6bf0dc9a7b52c160fff51da9fe1df7ae4d75ee5da2dc0af9f76db4fe32d17ca3a0566a43c8f517ce569fb206cf75ec5e1c0c6effab35a9fe257b3b536befdd91e94fbb7f6356edb74cd01c0b411d634173432cf989c9c182e6b8443cd6aa25fc10838ee0e2dd1da9357fb51d5b2988b84bb967c7bd6b0bee1599996d92f5758cd2cec7d819c31082c9200a6046332fb2ec646aa49ccb158f3bc9d2d43d6b521dd7f707b175edbd3b68cf146e8dd7a5d7933ddc0e10a04476e66a3e17a6034e60dcd935489a064f5573bfa9bed27642101371247b06677f34489a4e71bfa93e5f52d0b618027245b1c9c89269e9d44ad1d9b82b874dcd444347c744dc6e8f3b119d41ae6b10cdcaef07df7c7350f93d9a07df3c558dbfc05f542bc3f8c56aa552a9acc62f66d7e2f2f76898e67a28738f465bbf4bdda3c156f2edec9a5cc93d9dfcfe357abbe43d9d67c98fb2fea9cb9f1b68f06be7069a4fea52e70639f8aff05c22bf7fcde778e973892cfc9a4d72e99ac859453da18da61a8354838de59f8548e0915d59fd35138091ef0861e3c798d5fca339fa79160e24749e84ea095ca16a9e85c399b1670e69e64c2c9583e3f7978323a8390462aadb837c6776a1ca43fde995a48bbc0ef5d00e1f7dc2c55217a949de1a8103bd81d38f030f083cee040341100d300e82a01b33a24ed7b31244511a039630d72bb702650240a62e6c094434908b341c959d00c9447323d4439d3f54650b05ac0e93be9c55b3b1069317e43d5693c3581d5af1b070c24af9aa5dcdcba3664afaf0e19e2da25dd756dd7bcd35bdb5ed92cdb27af1ba6bb78df6f78fdeba78d107863fb16851205055495eefdebb4459952c0fae5d7968e5dab0bf5fb768edf816e5cbd7eed8d27fcb28ae19bda55fd9b16edda1ba9ada4686af68fa2ce921af4325d4c2e3b2d588025715ac2ce3458122875bb1fc91e2c111b906385ee0b943c0f3640c08e9590982006354c8b5af945014b5f790a96a54ab3610a6aea0855c3dffc32c4d307b966509efc581d151b9d8eff7d7fa6b5c015b6dc0a2d3fba2fe602483ce64265143504b11ee7627e392d89a8ca8795c48cf64437f6a43aaa5b979f7fa0d7b0ed787aaa28d77b4bef7fabf89d9ee8844c37dcbb78d5edb39645d7ecdf26bb7ada98ad604ea173ebdfbefe50adff76c1dd756d7537cb5a557b05a1a062862f2158079acb06d258784749301abd51ab2087a6fd4aead71dc1af407adf1b3ebc6c7d76ddd8ab6ad5bb175f38ac195d79e3fc4ddc9d6a023bd9d9493d7a11d96c3a8bcde893a9e6551d609bc6e0a7492a0938e82240a927894a9c47814988a7c14889e4aa51dd9fccac20042ffa2eece587338e8af2cf7ea459e83766c33e85d5121108ec4a9a08aa939c7b5bce4e14c3a4d9665594bb3ecd6ea2eb15f41bb2bdb46fb1e7d5bde63fc80940c9537b7451b36addab8b4b76d60627bf9a6c3860f08b1aaf2fae69ac8d6c18dcbe48ea1f75e2f1509c168b5bfd72406aa43e5f7ad59eaa9ac0e94850d76eb7067c75251322cef1bd8b8aadfe5abaaf486754eeb86ae8e659ca45fb168d5b538461275fe986426896830260207f5b08f44c9eb60053f74c397d49c88eec111b91d0451270a87402749ba0314af3c10ba63458117c74132a19e93f4bb8dc821aa995c70cc80c8e19057ee54db32648b14d957d17a540e0402361b40a03bd0d5d19e4c3435d4456b2236bfadd2e3062b582266bd9b25226ccd6416ca66ce69cda6fca3f4e98a87b3f9a6820589d2e20e31f88b09599e58b182fddcb06fdf86ce8db1d8c6ced6254b3a3b17af2c2b29292f2f29295bb9b8735fd7dec52717efedd27ec5c6d79d5cbfab71b8ed64db70e3ba05c923c9050b929eb232cf11fa23b900802fc06923b4c156965971b80a051dae601558059e9b32200291908c836444bd4ed28f830e045e27a8e9a346a9b819a3e266a8a909a0a9ad29196fce54aaaa287339182e4c1a2e2083826cba25773e2262ad89b028b98391a033c8325a479c5a01307c4f1e1ea63368d8b06f69b94f5e916c58f29eb685a79bdc5eaf9bbc5e888837b358185fb7afd17cf2431fc2cd9dcd2d5b4bddee5220508c87b49c1a15b050967d2e82c48c12921520b13cae537a9dc801dc2a68774204f54e88eaf6d3e97415ba0aa7d565532f6794672feed8ad5ac2eb38cb4f2149c14838e2723abfb6600ca5d4d4ba0a9d4ed295afee367a3c6d5e83c58887b0beb353791177ed28adaf2fdda1dcf364536a6cfdfaca9e8db16f018193f8143e455e677970dad83ad5536ae5808c0b2a710a8c38d909cb90c9049097f146d4b09f9ff5a635afa0c2bfad59b370d1ead58b42757521fac2a706fbfa06e9abb3291c696c8c849bd8dd1b7c1e6fe37417d569f4544db1c791df37f8203e8f49cae37af07952c5da5cd23ed253fbc81ea7afd37b071fb861efe003b407e519ed9e07bc913e81d7b39a26171d5bd0d20eede3bea4ea04b9365796ef3763185d7ff4e8d1a3f814de747e2dde0408cbd2274811ab19a39e5d5c24edb2667cea7d14925c16abeb8f3ef4d0d1871eda47b64cbcf2ca04852b963e4738f23a94410db596cbcb5c06bd4e9208c7547c8e20379e6780d01643b5b53501967f44d321a4483297a14e92b4e202916496b33809e71eed5e37de17dab16df5aaebd62d5b1c28f7d4983fea0f55fbbca565d7453baf5b5def4985df18d8b26560e9823d3eefb5e5c172d7cf4c45c9e0d272bfbf3c6fde7648c831107810860bee29650a4bab325b80828b4afa8aa890cdfe9ec98baba18321e4a187f6fde6b072f8b5d70e2b87d5dc5e255aae19335442032c9797385112cb9103c2b2508a48c42935e7a50e11440929870690464192289e241802a8ab0d057dde524f36a19f5e7447a1202ba514b706b97038c2d4326bdcee26895001df259e44a2b63691a8fdddb6f78e99bda2e8def6de6d8d9528161b8dc5f4455e4fd6bd5597643f0cb3617ca873b232fe5fddcaafc92bcae65790dffc03b3c562568a8a8b8b804057fa2cf191d7c10301e8971756f88820525e4256682afb14f012aa1247002002eccce5f71d030eb9a1d2d2d240a9df1d09d882161dbb77687565eb4904f2054c952839fd897038487ccaadb625b18e2dce44c9e480ca1097eced545e5b62c312db9218ee5466bde1ae58ffbabe232b7fbdf2489f7c64e09fc2de575ff58681a47f973e4bf6901f8319daa1536ea344784cbd6a29520e730c384160bc5f189350e084a1a22280a2f6a2b6e6c6aa80dbc930af13ddd12aa6d55242b53ac4a0a82a1fadf1985b2ddfa9717da66584132dea376a9c133a2b2bddeeca4af7c889f5cdd192724791b9a4d4d7b2b9b373738bafb4c45ce4282f8936af3f31f2cba0076ff204831ee5564ff0b76b8f0fcb87ea4a8bcc0e5fa96f795dd775adadd775d52df795fa1ce6a2d2e8843c7c7c2d70dafcf64031abacbd44be067822105e98d2a1c01181cbea0292ca5d25c65dd9dc872a2b2d1680cafacaba48c85261292f71b1602d4a68ae39c15a3d24d11256055ca1a0a7e4f8d289f5eb4f8c8cb09f9ddbdadbb775aa3fdddac40d6b8f0f0f1f5fabfedcd2b9bdab6b7ba7fab33fe8799ccef8714f30bb56ea5c9a2e291728dc79108b9787f862305e1c36955fff313d83dbc84e305f10d746298313dd51be8032b2ab2d043df807b69a264f50e5e303e915c4429a5846d15639ae576ba023e06d02aa9754e7c94b5be655550f6748144b720c2012743b83745c77614ada93efd36acc4cbe6fe7b6785373bca5a9294e9a0e5df7f1b143ddadb7dc4b6cd71dfa494b5b5bcb87e3ed6d2dea1ca5f49fc90e320c1e70c976ab94cb7a8447420dd4686f55a93da9963071c563495162fb35d2dad8dc1889fa1ba375efeb5b29bbc38d37f6f61c6eb865a2a7f73d8d1157ef40dffba2758dfeba7063736303cbc5f795b480a7d22fa2c43d0b70fe2ba07f14c837ce7f452d8d9e4ec354ba0a7f927e0225eea700e779b56af879fe11ad56d3ec33e9238c07452109cf3c5189a290b18f1b40048e88dc4e30f0487406326e441d800eb68124310bac6225e8f5c21808824f4f0dbdd2c111390184e339c24fe55a03af33f097692cc7b3ed04d01b04fdf865db4bd4fc730224e28df510855a6657db227e93541e159cfeacdd5c79191b3bdfae26bef3cf8f1ca3063462cebe8eb64936cbf2016a5f7f94dad541bfe7e0aa8f8c62c9e82dfdcf165ad772d3f816e537d7eec03bb246353f7b3e7d965492d7c107ddb01886e035d9596d26066ef9b23eb9bb43341a2a7802c68c6d1dd321c71b78ee901ea9f92b2121152bc160803151400ec0bbb2c864e68cc6ecdf59233baeb5344c5d4553b9f1a2adb608986bc3981c5632a3dbb764494fcf92a125833d8b7bfabb3a13715b6dc04e2d705bb1541105375edc06bfc457aa79ce67cdf3caec3be5338dd8cb6cf5ed89a6a6f1f51bf6de501f0a46b9a317b1df6763aa014feab5df5c9fd293b1e6572c5a7e6d6a35b5e667fff172167eced2071067be9e3e4b085bc776d80029d807ef41a3ecb9a6d6cc1573870eeedab9f9da0dc33a4b71979fe7c09259cf4eb38e707c31cf1d2ad2138a6096f5b962251417c398d1200a28502c3b6d0eab5db058f23ecb2e6c57a68be2a977d087dc7aa9e65b0c02c96f8b6346aa84a80b5db77ffff6ed1b37ee7fcffe1bb7efdbbe77636ae3b6d191d583032b972fededeeec48266cb501275b78d7e5169e7fd7a922f7eefca60c7d14d002f1cdfb31f7e18b90cd8c412397f639bf858539f2c92795f93ebd72a2ca232e4e31a7cf121d791d0250074df0bcecb0204218f5180af09c4e2f2191788da01a401041140e5159a307dc097ad0497a5db63a59c54a034a123bf9205e92a1a146b5154c5d6933397ef9164088973563eea64a8ed28c23186c6a6ca80fd605a38c32ac16a35416e5fc4e3f35e02fb1cc39c75cc89ff01b895d09dd74206fd76fdc73b83e1c8c36def13e7559b89812c29fa132f1c6fd0ce51dabd9de5651ded8f7f4a733086e57eb7c281f4f9fc6cfb2ba92bd2a4eec599734006e01c452ba5d4a2ee6aece559794b46b9d6f1c3d4a3e36bb7f1db75039498d159c790e82c4420e82155ae46664993859ea3de086356ff12efa773643280058815a46011bab23aae6dbf33b83d6b8d31f0e4722d8f8cb7f3e715ac79dfbd3e4a4207122f73039a8fc54f9e96b06e10d32f006afe35f0520ca17d31fc3ef319b36426d892a0f91041712c9ae233ce1568888204828ecd4a1048497487ef80e0b445dc5f37c840ffbebfc5501ab452f95b149a2331870bb5d223b856b8d44c2adc97038996cd58ee5244974c57f7af428f9e48d23a241d8b1ee8ef75655a3816f6f6969e70d1809bdf78ef529dec055d7efe3bea46c9b5873cfd0d8e190ff93f14506c3a2d827ab82139b073f55530344f974fa046e61f0336b9a1ad13c877c7e2a7215619a352df954f8b438b87f9e9c247dca3e742abfc513ace4e804f9bb89745a793e7d021bb91e0870c34f0370dcf0eceb54ef397f227d1a0f91ff60e32d56a9c1093c08c00b3b59e0c5160e357af072acc6e170e6eb7c2745aea492e4531d0d1a65cc5a8e1ec5af1c3d4a1e9f5db18e5b78fe3b191201eefc23e913f0b6e67f30824776b1580f3cc613a29e6a6452336bfdd1d76cfde424fe7272127f3b39c9e6a7be00803ff7f5f469f8259b8b046670c012d9e0b05bcc260130a3cc15b3bed580327556aef9034ce69d107b3923ac728f7526a1ce0c9f9c9c244b1e0b56560694ddda14d934ef3bea73994cceb3b32f6b789e60b015c3a80a4b1945a4c80b3b41040944692ebe8379f8ce7fac10efb93a3b54dfd380746a614f59ec3f31bb9cbe34f0de9cb87902b8f34fa74f633783490f16906583a5d8a4d7e561cb3c0722fb3ceea9bc52b3733134bbe0e8517c8c61a7aad29fc34e3e66f2f99111566b7587f262eb18a915f0a5ca1c5f2a7826c79ff2d74eca4387c6a63456c5b00040b2bcca0476e8913b8b0991445cc1230808ecba8d2488bb18c34089ece43338015c65369bed66bb930e6663bcc2ce12856a6ccb4dd1e0777ef2ae5ffef389d199b59393fca90d91e2e29a738acaba2626c8c0c41fe3a6a2c4cd140ee56be9d37823f90f10c004edb2c164d4097cde6a180bb06099ebe41b7d5c2dd92be52fc1f385b4998f794ee1d387898e5b0db5d00cedd02d77b437d45787ca4d4611c188245ee6b2734404242b28c609c214fd911fe31009977a9c0e4e724573d599b54aae8e8b557b75f36a8d57b753756b109dd28fbe4079855f296eabad5c7fedc475bd5b0fe264438d6ddde6dd1b7b172d51de080782a1db6beaeb22f817932fde70cc228996b33efeeff5ee036b860fea6eb945b76f38d4ac7b49701d1a5ab757bfff80a1ea1b09d337a5bac0d9a7a5a670a895a14e29854df86bd2067658abd68ef3883c217a8930379d0e0158002be7a3f8ad10907932f31f11584a6b9d96e7be28df9d2755442399dcfc7ecd9d174cf8bf7ed3638fdd84fbe84fe5042b2bf738fdc1e4f0ccdbb009bf4adac00bdb5578ca7422cf11b351af569e3719080549a27292c15445df52a00a9f9354414a1f5713f0cf53adbd48aac895baf31702495fb3d51aa4196073f03eaefe52ede457a09e94909d600417b4c909a7c3ce11ce82485c6613a71503beb4ff57f2e530157706855c25b4bfbfe9e73fbfe9e73fc760ae1a5afdaf0effea57877f925fa54d9c791036121d69d7f2d2a939e98e2e7fc43f38228755a7ceb0017592a81b36ea8968464994b6f3c4c4a9d76ac0cfce5605e478b56e9911f5f471a6d0a90f9b30f3280e8cca018703201256ebc73b2a1ce5a525769bd5525c6436b1d38322c91dcdcf8bcf6af8fb13fef93e534ec7e33535f1788d727ef647a4896fa33fcf3f34cf87786b53756d3c5e5bdd244d4c4c28d73657d7c662b5d5cd9d1313131407e91976de2a809ed59271421016cab23a2b8955eb9574281a2531c73c5782d1681833a1c1e0350cb85de55e57d01d703a540ab1d92c6646c45c22ee14347031f3bba0b8a0b2c9f517ca3772a0ce4ce7cde53b15e170457945385cf96fb8f3c31ffe3e05fe1efae3ad5059aed6e0ec9fe124d15d619cbf9417e7af9b7993b7d057b6d62037f36041fdd1a1e58f540f8ec8a5f9617f02bf2d83059f26422e5da1b440841416297dfb1e7157e6955fa414673ea3c99162d8f3a41e0961572c4c8323724844daff01addc9b9aec65d78565df82f4b9c28c38173c352a17b1e457c55687d59ad395fd093f933609bfaa298f9efbb2709a099b61616838236fa8ac999800c0d973ff9b609d15b83514d6f35f256f5f002bc9c3ab0796cafd2eab1e790ab05ed271ecd42537be7ac3877d912d7ccb9cba1e8bc76ecb826290bc516e0e287ed5e4084722ba5ffcf2aed1997fe127aa8b8aab67eee44b47f0efa9f15167b1343e5c081eb3403accc5ddafaa70e23966f378296f74e9185a25cc87315b5746c21c843697d56ab35a6d5485236e7790c143614bc62551e2ddeea09a8fdcd4f0f05d2333dfe37e1d8b9d2fe57b464e9caa376157c317effec52fef3a5d67c2eeee572726fafa26265eeb282aea7e15231879ada388d1e6148b24ff847656a59374c772a5387709d43ed3120f705e6e606e1d4ea9228a4ebf444d5786320d3402b391b55cfdf90f8d4d8ecd1e262faf3dff3277436a32c5ca719e3c3971f3c47df7515afb0f081291e9570d725442e070851ac0c20ade230779976a01c0042697d52a481e36a8b626713711679b9e3c71aa9e2ec25d4fe0ad87df5011ff069bdf57351a71d1f999905c89c1e90297d535c7e0cc8d576075ce7cfec9bb4ed75bac0d5fbcfb097ea4d0fad4c0502d5048a795b1f409bc89eb810839a238a11809297e15d4ea30c5cce19c4e2b53508f1f263b21488e282d0028e2794882ee51248f2455a734517e98fe1a36936f3339e797cb0d7a1d87cba8d8312248b0347703ce4ef5be1015a4890093a45419537e88dff7476b034a27d93a7b3fd97af2863b0d446f387ef8a50fbcfee2811781647166050f2c91af29711890a79aaf2e873751e2c55d7a940041c29dba7c04da6c00368fcde37631cbdda56d2b7587e7a13162673bcc2da97b7de67323194c8ef023c38fd27d3ff385582cb3af546c52a14177585f5f3aadf82189bf201b21408ebc04289123b33f4ea741cfea81a619b2d4b9501ad3830b7ae52eab881c1170052f1214008725544bb6eca41f2033f51156190c000697c1e57450a4821ef4369de4ced042381211f209f0fcc25ffeb306ba387cbe3c47101a29a864904f960570796185bcd4651691e708ae107584627598e29670925ab71b387e27fd0299cb3903a0d7e02df5a81a8706a4612e906e6d6fce0b69492cc6fd66e6bb39b4e7c39be117ec7d3a7d3ea5d5bf0d9223ecb62ca54f139d092b5a89b36f4210ff400e82119ae50631b397d5acd4b7b1fd4c953f8e83ebd4cae04055249b2db39db3ac0cff70fedba687ef527717de7ad8a2722b830038738f86b3ffee18443cb7aa3837c6417be1187886c9947ab9568f8c56b2061f4181eccab7f302365b4617885b8389dc107edc323979eeedec4c043d3938fbb53772b3016d3e749f39296f32e6f126ee185133ddf088cc0785d701f238c8cabe3903365b216fca8d5ac09ac4ece8fcb902ce948582b12675ce543e39d4395330a8be98c72185bc9a3d563a691b9d333091941b9d49250c367cf1eec7673e9f1b7cd3131921949bff1b4cfea4d3ca6496dfdda834cfcbeff8b7e5f40c11994eabeab3abe555c5c8aa850a4897640a789d5ec7eba7442406897040b85d39e5d6883a9d7e0cf47aaf7ec0edb2d954f5d6e6b4396d369b3560b39aa4b2287254bf4591ee17754298f03b0555af0daa5aeef9efba36b9fe6276a98ec34794d5eafcc849fedbe78e51adb68ceab76450f904eefcb0f24d83a051aef2c0e1c31fa3da2dd57281ccfe0bb491c5648a55c28ccb4dcd0df575de5257915ec7f287b6446bc3c100aa4c5cb3e2c7182d0c95faaa38c9134d4692adc948381249ba5d2e37aba1ae958349ba2551925c6eb7a4661a1572a59eefddd4151826bbdac7c25b2b97570816fbc12a7eb8652c32d6b1831bade8ded45d1190ca82ca3fe617836e97479b65813803bb03c67829dfba32b06347eb7eee1a5b6077c049c44575a3f268ddde9db10d79e5ae819b390d6d384ba6a016122043879cecede9ee883536d4977a9c45067d119da59c6c4dd445c3013f1ae69b6869884e341449b66667aad6eeb9709eac2c70fe4cf3ac4665f5a66eebc2109bf65872ad103c5025d0496f6ddf4986fddd9bba1d01a3640c389433f9d3262fe48ccc7679d4d3ed5571e058c4ef69dfb1a3676716030b1a46e551dffa48787d591e0e6e28281c4e940fa54fe340ce776390448e5c8defc6ce7c3742101318e7ac6c92383039fb0db2647252d91da8aca85a87d71dc5edb34b48ed59a7c9e402eefcaef469789d8da9f9ef8a8c868251afd47f67d79cf97470e6c1530178511d1fbf7ef4e8ec73f9409cff0eb7300708f3bdee4f9f864f68b068be575b7151013457eb7bb56bbed72c5439c8665b72a0e1578e1e3d5f3e17bc4210b9995fa44fe03467020e8ac00351b9da53e276d92dc545469d240a7c91163f32f7da9ce64fe3308173290f9d6f1c3dfabbd96f92c5ca9e40b0aa123f8e1bf0ee4055955fb99e2cdec7bdeffcfd387614b71fffb3d364b2ffe1f68ffede693239fefc17ca7d47819bfd73fa30d1677d6f0b65b92154ee35083cd7561f761419f53a1de1e902712b62487039f01ce17876e31e38d846f5e53160a7930e8fcf6a17246fb44a75b845729ba4d0efc68e9854df1ca81e38bbea808b10fda1eb7a0faf9b7d26bb3d9eeedd625fb779f7a6de4dd74f468215c39b94cf8783c1f05fd4d44523dc8103fa5b6e1182bf53f782defc5829f772c6f3b65f77fdba6083ee59bdebe01a6c69613e38a55f6a0a875b0dd4e49ef946fa30bec9ad8666680399ce5b4eb434d6852a7cde528fc928720856428981d8d9e43bd8e42f9874437d55d0ed723af84b781e2f8a88b90e487c5391293308f91529d6a43a200bb1216fb6addbb27b53efe225cabfabaec8ba2873455226205afee41173aec88b20668e4b32dc6a0055e6013099e7d5ea2adc201f0a617111ae002b2058710a2cbcc05b84290716b99ca4982b2ade65d3134e2712dec2f13b4d0850bad26e60976ecd4682e85b494d4baf30505757d758d7d8a0dd35ae0afa2b2bcae6f10cbaa9751797e2ce207b0593f9e539e3525cc81796f1645048f89db364a37ff55860dba1ca8dfe8f54e6bd9f8de838ac565e51a5261efc48e023780bdf455e7972fd53eb9f7cf249f5d7c77262f3bea7fee6f061262feb4938e757b450dec1d9afd2af18caf72be6961f17ab8e456573be04d05c8bf9628dc9b57a9cd5e0f0534ba2b2a2dc525c2412ce6b2748fc256e97d924be2347e7bcd2eb371a686b2e269c2e00335ff25c6023523601591b1197662f5c595dcc465461083a636ec1e90c25b0995a864a67a036eac7efcfde7ff2c50fbc74c35f1af48613ffbeffa5fdd47ec9e8c926b0c335f202ab3eff6c4487dae1887efec31100b3dd6c2f2ea2263d233866202654159a699342c2cf44dd96c9c999f5c3eca084ffd2b9f311a3b1069589d9af4d4c502a99f8e3cd71b3390179b64131ec79d240080859bf15cb997880b9a00e022fa200bcb08b79b18635e10bcc6fa506dfb2e8cd8b3d95f35bd9ac560753c12994741b30a8fdcef31b2988c217cf7d55055a58837750103578d9b9d2dbb2a6f79bc10d37ca7aa7454f987dad025c4d9128c0010e91103c9877ce448834ac4349225b80486495570ed347e118bb537fdbc51f1c952d454545ee22b7937993f20fa434b8d513293a17f550eaed5d2af8e23dec60eacfdfa0b3ca58e22ae6a915ce904fb276ccffdef9cc7c57a32199cd67c672b9f968fe3a0fdde96ebb8ea3d3c9d944ccfba1cb783ff28d239bcde6b17934b71d854bb39154b098812445922a6d53436978e6f31a649b46a895745d736ce62146e5cc67a712cd6b1d450bfafa544aa73a650bf8f039f21e660fe99eb01a7924b128523e22488c7f68eced39a54569c1e7f0b9fb035e5fa5f2bcf2b2bfaccc8f3568fccfff9c7cf3cdf53641b07dfa7e9b20591f64b6580fb4e1cf998d7fe3ec5bccb6bff1456daf6bbe0a27b4c8cd169ea86631f3a0889a0765178ff90e1483d3e0b051138bee12c8da88a13c33fc7ca251b511eb4cc2ab33b717a9ee4a1d47a7aeb928dbf36c73755dfec8e060ebe230f11c91e8baa048f53391528a28edca5c4c1118407c06208fc14357a5c4965d170da4b95b380716ee8e188dd5fcd8cc9f46286ca3bc210f36b9705fa7d333bf8336fc3b157f330f321fc98db35f56f1f82dcd47424ce420c8f009d950897aa90c514fb45b4bad20eac463003aa2874320113d91f45320a0709b01753c124947761b518fa81f35a25e8f5b39443daef6cac96c431d90a92b6f392adb7abada5a634d55019babaaaa2a68313189afddf7657799b55bb799f7928a23291e6b4db2abcf6ef5327ddefb043e34beaab7a9cd67775b2c6e9b2fd9d494f45a3d168bdbe2a8f27e9e21b651707aed25f16853d267634f79934d8df429abdd89c71bba96d6863c0e6749b8a636e476596d2eaff43386f36ebbd3575e132e713a4b42b53521b7bbc405399b732318c0092be1b372858310108b25c281130972dc0abb8de778b2cc6434002f2d55115e0722014e3c04c849808778243aa4b29bddff96d4fbdfd256839e489cb4da2b37d0c7890853f4790ea5a94b371895bd2e976ba56bc592febedeaeceb6d6e6c6684d285859e12b354aae8cc09d23f4790dd5b95ace79f8cd203853063a6f8966afbf9896707e05c3abc3b57b456fa2b3cc52526c71d94a5b9bfc8de576bbc7e6708fafec09b5f81dd6128bfa45dbbc0a45838aed5043d79268d8e5747842b5ae728fc7e571861bba96ba2b3d2e17fd4c93c336721076c01764433b82a9158d2443e34930980cc7004cc40887408ffadb2c45c4c40ead8d26326e2d36734644e3287b63c4ad2ce1a89112795bb6a58912f995371d95cb535b376f1a59bf7a70c5b2fe45724f5b32d1e2a862ff8216dbe5287e9ef741c9a5f10ed73cf47ff17df195d4f2eebad63247a9d5ea75947736367696db7c565ba9bda2adb1a9adc25e6ab37a6cbeb6c6c6369fcd63257a93ca86ee7796db9ca556f5bbfaf67287cf662d75947534d67554b8bc56abcf56ded9d8d4e2b594589d2e3c1e6defaff2bb9c0e973f14f23b9d0e17a5049743fddbe5306ac73fffa0f739dd0e872b403fa54f87437eb7c3e90c844201a7b3c4c9ee4504d227f02cd7034510942b8d3a81e7084a08b8a2202ea1088a7c9ce48eba9398d3ace3e8aa9bfd05feadf29adfeff7e3c368be597968df11e4265fbce158b14eb2fc80bc707e2de0ccefd227f0db5c0f78a0568e943899375b1baae882a13ce0614309054349394b8f9b7dbd6ef60cdd0afe803f80a7d17c730f69cd9a710b67e680f005e5bf54634d2a7e69961af78a0efe01cfe10fa0128e3c59514e0853630c548d010e907087800722f0641c044061544211801381a5f619031470c82bd7d04781c3a9cb3e3b2a17034025545605eda1805527f9a2e8f48b79db7b0e2b48b813784ee9752eae8f74557bbcd5a53effee4d4bda17877ce1d232bf016f3d5c51551a2cab28f7fbbaae59b0ae315a5916aa448b7a47e3179064b1febdb01c7ef7840bf5d958ff0470609238d338e84132e8a57130e850341bc45c08bf992098613b088271ac088dc60a61c0bbfc91f2c111b92b2fecff729d003193c23ee485a03388069d3875f16ee072bd8cb21afa4b172fec835ee8a142cd5e15561309b01bf7974a24204a4524bbcde7a0bb35d9432e915880053e0c2d490c248255c9a164d3b2faf26a87dbbb7bcda2e615f58190bdaca1aaac76f1a1a14fa85111f3661b681d58b07028190ab85d5e7787dcbb2214287184db12035b97e4251ec0d93f683a761f3c201b1045038f44ccf255131a25e33110241485436040110d223bd9bd4d8f1287689070b70e4542c4511d8a22d9ca5a53be9a6b29093875e54d47652a7bfba0afbbabadb5aa2a68afaaaa0adbcc949f52453c7c5116cae4d7c539653c7382f1705995c3e2b6583c562fd521544dc1ee6b6bea5d35eeb45be9c78d4d49af2da36644e32576af533046b4738e57249fcb6675b943b55463a0d269695743d855e276876a6a43548708d794fb9c7653ec55baefaf8557f1ebf84d48c2fbfe67d4031b0024a13514b48718fa8c5245f4229bfeb2f2ff6655c8bbc72f14f2d6529bc3bd7b8e907f95897326bbf3c47909fba873594e9c03ce1cd7e86e177c5936ec44bd11d18019ba6bb3a1d5683d067a231af48718d1149b8991c95e8311c72d4526ce40886194bd3190ad4c5e1b28e1b5e79a1af53875156d47e50000ec825d5bafdbb461fddac181654b162de8ee6c4baa42bd2a64b367a890ca842b92e91712670fb9ac4867e713ea695b9d85938c568fbd2ca9896f554437763071cf44746367b9c3cb3e6fadef5eb6cde5b49658ecbe26bfaa06587d0e7f7b5de67947655b9dda8fcd67b3fbdd9fca9ecc129dc35919a4923c23d19d4eedb7cb5fd5df1ead7296389d6e9f838a71a72b5015665f05aa4201faa8d3a7ff47d5866c8293f800f93398c0026d72428f59610b2872d4c0bbf0f21380a5b8c80c263059ad5649f244d11ea79653241c1125895d636bfac7f13bf194e23d582449c587c91f152ffe0bde817bcf4dfcd8e72929fbf11f27583cfc27f036f223e020bcfc91987a0a82701b20d6e28057d6672f30b0ababbafce8f84f64e2da737d54b2a8fe8bc4b63f2ac2a239ad0934e02748136b2b4188b5be547c3ded2213637fdfdec1073eb677f001da532ec63e17df7f15b03c8f4948a7e17e72177e8b9c05c23dc774aec3e496740fd705c5d0afe6ea300d8ec82e16d67e706eb75ed999cb0e967f6af484d35fc5e93dea882c5f018b46703a2429a8bc5854543c385e97708452e46fad66836e655d28e0a9074c8fe2f3e919723718612037b69bf57e2097316c2bcb18b6da2bbbe6a412d3be1865875a4630d8fdbc366b96514c635c5f28b5dab6f777e0f3eb6cd6e52b137d80ca91746dba3cfd15304384e1cf9797ee4c3d1344d009604613a777b10e85c27b87dfa9a8aeae28ada8aeae7c395456160a97f942904e2bebd3427ab51afb39fbf0dcd84fcaf3d396b49cfef495ac9b104cc695e70f6fb9f5cd8fb1b6b31f460b94e3be2b696bf727fce5b37bc8dd68d9ccc62d4b3f9dbe839d397958db829c55d90131aeec598515abbab82540e03099c2d739c3ff9fb93f816feab816c7f133335abc2ff26e79b9b6bc5b92652d5ec021b66419b08d3166c9352160d912b641484696cdbe84002186504a08251b25294d0949932b20942484d2344d292f2f4dd3bc364d93342f4d531e2f4dd394f2288bfcffdc7b47f6953159deb7dfefff079ff13973e66c3373e6cc5c5d7b24dc636114a48af9a141d029bc2973c8898c20f85a37581825f8a325250c5352c29ca5106f2ecacd2d921640f006de808609fa06f313fe7ba128656c7ef006f1b73db30b01c113781b7a98247ebd75536c420fafddb8056fdb25acb933781b3a29c8de7a0c91a6da84aa560f8b421876e36d582fc87cfd755f6d4ad3549baeade85f356b45ff2abc6dd72ed17e1fde863e2789a0843c4153aa028d5d3547bfee2e21b404452dc59a8fd68ef847d63545f05a6684e9281174648bdf4734c9379b8d39c48f439aa9da8496fa47564d8f6ce63d9a391310acc71bd0fb241162412fe8d2089dd926b90f41860822f4a23f55be4a4e9d5315594ce3b7e6bcef70941516963b1ced78c31c5b5e51519e6d8ef0b7e4cfe23bd05152f87fe71e95bfdbedb5b5767bad3a2f2f4bcd306a7c07ff18cc178b3a2d5d9d9596267c15c4667c377a87a8201bfac4fca417fe268400229dc29738210cfd4008ea56c83122a8837f1493618265643320e1218c7fb890934e186796772b909cc83bc4cff6b3212b893f8d2923d5e2bd3bb7780c137319ea284e894d8a8e4b9855a3678a53e254228aef4e4b898b5125e4e6554ee1b184f8fcbcca29e25e11caf75f27e6f32c79e8c5e0c7280b3fd0c3b7bd3a5a829e816448842a41b63c9a8f97d06518fd0084086f2bc812e1b60ff16fe7121293f342739d66a1b702559b94e25555afb6a6e6d69935b6e49a59cd53dea94f8b4dc9ecac55ea8a0bcc80e087a3256897f81d76624c4db8e3b14bb8e3b11d415c8c5201f1285e16969eab4d4a61764d294837a5c95c3927cda44e6b78a7569f57af4ed131aa8c05fc7e477246ebc86f2101a6fd2ff7ba5471af0bdbe714fc46f7abb8b8f8b6251553a2a2344eb250151315314b57189f13a3e7e3792529447f26cf09f15cf6f573e9adf2e8437cfe2c2e665e2b2e16f228299c2c8f926c344c1e8758c8fd8a1c7acbfc49b225f9f3315286f691c097df2b546c42fbd66e182165f70af1f71352868e0b328937e54c315f0e0d6e129831ec26c5582ff07ef51d44e17992148f8c8c88799214a2cf4940bc035e7847b25dc849bca764091044262829d6fc61d5c8e0cea1e62852b86bd7cc9b747c798e1ccb8faec191e19991cdc3a450cc8feb0883de273f14ef2afd3ab9313c2fbee758ac2d2ad62d76b41366567d6e41416efd2c212f3e8d3f413f242f0a71f4e577887c453449f3e117d3a74f99327dfa94b17cf8c91443c5d4a91586293599e9e96a757a7aa6900f0983de2101c886aeffdbb9f07f9507099396121b7b8b3c48cad18b64f797c6ee58fe23e53dfc792e745ec3e4c7c2dd1d7d781dfa9c2891829c83cbe2cd1da39785d35dd42817bc8e579107400e49902dfc068509fa60277c07be475a5bb8c876560803a290b75b716cccfc198d55b2e4d8154895e2ea5e7ca72c2149de4a106a568b1ffe09ac915fc1fab51476768ac67b650814580178731c8ac1b138267633a852929254be44149f9010bf129472a4ec24086521b902c97ba323b1222a02e31805ee85d834949c1e9bdc9f89d2d52823253da31f522145959ad20b490909499d909494d00d0949091db4034bbf869548de0a8a8c8a9011c091d00b0ae0adf57f336bd1ed6c7d571c8ae197d2d7eb930ccb204601dfb057c9ff6f07b1a09dadef9bdc5c8a2a69f3bfd8dead7a163215bffd5f64a993ff573f2539f9f1ef3ef2d0830f7cebfefbeedd7acfa60dab86560eb897397b1c4bee5ad4d63add3eadce64ac3454e875dad2e27c26393b393b29b950939cad509713a5a53a5193347e3b537e9105594c0aacd498abaa919842696a23f42a6fa8aa16aead1213494ab2b2ca644c1bcb854a854239f6eb5a0a4d7eb1f0f7a216f11e2085d2389e28d3d2e8afe68a372fa5548592697e91a54082c7daeea93f7cbbd97c3b5f50de8f0bccb8beb220f8784e46460e5f6eafad4585b5b5c1e9a5daf4089cac8a4b912185d5ebb52ad2121393912c314657bab2a7283d3333bda867a566a8bb3647919fb5e390bdc67c7b6672fddea48c54555eada6c6de9e959aa656a7a566a1b81046187d43d78d0bc839a7a767ce09fe072a6ed81a7c7fa53bb822f8bb1abbbde604ff23f848757575f0a5f9e972264aa64a4b51c92263a35353a3e3d2b2d25411f284649c3eef76d7489ab68890226dda7daedcd911f1c52a6fefd4e9b515191551f9a989aad4828692e9530bb378b321f359a9e25d500b835f60969c07029950509f178710fd4ed1b1372cc2e56464384395a112ae5d57269a88b9aa5ab81a4f539da6114e55c2c5e56fad5ebd5a57f0ed8c6ccfd1a39e12e6918cacd5e8679d78f1f5efeb58b55ba54b3a7af4e889c296f4c124737670b768bf7fd48afe4ad6821c32a1aebe76ec244930f4ca1101a58c287b41a65008bfefae581219811532c56c854291a9c84c4a147e314db828927e6f70a2292935cd6416ae13d790a2624d6a4a22da1ffc75fbfb7fbbebee94c4a8f896459b5392a312d01e7431e8b8d18b2e067f5fd122eb2a4149c1545458d1b2a02af81720601ce57011590e99a0012334d637188bb042291caae508cbfb650423502a90b21f1400c2171a0b7fe9ab80d90874e5850579b959ea64555c4c8402325146a422b53c2d5fbca1cb347e79e9d86d5d261abb49a662c9869fe6c9d06832a22a3a4ce60e7d947089d707e7aa75baeaea85282d8f4798b4764d063a96a1f9b37156b97656e59f3519c1b60c0d2eff44575dadbbb1f25054694e496565494e6914fd6e513adf61df2dcade388216dfd8277b279ce74bee4a4fd2206442c3c3e8a360c100fa28f8055e863d37f6dd6c43f23da347c8f96b25f4fb4b01827fc72cf9c597d940820dcc0e0773d04703c182e0dff172bcfcc60342dcacc11bd01f84734222748a7fcb9b15fadc49b8598fe06d0a44eff3ad91b7a9c76fb80f358f5fb9377ebc91c96489b204557e5262027fbce14fbe38ecc08758f7a0ffb273b1aeb854b7d8399b28ef0dfeb5e5b6ac9c9cacdb5afe5ffa553cd12fb77b9d3febabfcfa11c44116ec179fa834fcfac240f066e166be01fa37aefd4a44efa9a951b4a9c52e1484b3ca364eca5b5f7a2b36610177f20bd8a1440a99624e67677d7c7c7c7c56bc5a959f9492a78988cc129ed4241f482ac2fb571493d538afa23645b32473bc97b7c5c7a4346b0bb3d5da6d639dc5b0016f42bf271c644119fc44ec4049045220255208ef5f42e7da2839460a829645a3c848e1d2f11ae13ab6e276b65ef765fca08c8c142ec98c7444a34865a470f3f18433740452c895728572f3f8313a0ac915447eb37467677d66763640765976697151413e930b59a056f147ec985b1eb1e9097bc220b51727c7a862e212664dd1e71527c5a8627266d556ec752c2a2f2ed12e72b491c2d4e498d8c4f8ecec8a5a1ecb66b22b6a9f6cb92d3b9711c20430f4e16df821b249b8dfa5b03e3f0a61e017a6b00900190b53e1eb2f1285fbb9f93522a78f68583d3cbc313833f479d6df775dddb56b86f01de66785cfb4be89de24fe918dea3d31bc71e3e1095a455f9bc811888354703d9f22fce90f0d6d900106191f8704f000ffb8b555f20725c27765a8eb0bc2b8c8c649d9c43f2e48c9d3286e0e5125750ffd2136bb715e454db266c91bd44ba24f8c4a6ed116e6a6eb3c740ce8e77a2f403614c28f9f2f40fc7e42c313947cbc29f8b0516c9dec692d923ead89e15931166e37cbc16462f555373de429e50ab94472e2135fa4987a3aeb930134f9794c6eced8835fd4573ef88d0d4c7b717274d258408a686dc5eed020999355d13189f1696915b53c161f9791aa9ff25c68bc74a39785fbb4d3c0f415cfd0e9e900e9a674635101a4416a99780f67b170032a7d33271c2795c25de1c2d145bcb95d932f9e5dc4abda4d7feb49a9c8ceae48e9995ad7934cb1f4e2c89298e8e89892c8e274291ed558bda1c554d9b6b1bab1b17a635ba5a9654375e34076a647539e945c9e3f90999d9d39905f9e9c54aef164660bfd19204a74411621ec0dc6fa0a087dc93c0e7db3cc84bcffd59bd17cf7a0ffead2c546add6b878e902b263737074c66d5939b9eadb66fc2becddb4c978ddebfc4513eda97373b2047b434489fe28ab13f6180ffd25df5bec31376d08df68f3f8a67b872626ab618eb07764f5dc59595e5e7967cf3cf24a6c6c4a8bae303b53b769c66d59b9b9621fd69248f481ac4af86c60cfff1ff682facc9c1c809cf29cb292e2424d1e33b6e86ebd15547dcdbd40587afbbb1669cbcab48bba3ac8d3e19b417c1c93ad9ff2c4f469d93939d9d3a603060f49c60f93abffdbbd206378cdc6e0f4c1adfeadfee91164f5964f427bc1cb24195dfc467ac3f782bb377d7f8256d1d72699f1ff537bc12f435ebe9010251c533275fda1dce6e1c7405623ec052f8b9b40690452442ab64f08326528c8eee0c36409442a2367abc58ff50ca1401305e1abe5eaab2789cd7051245786025322d929fc5e8f78a58d642788fe463b412806253bc1de81adfeadde9648722259151d9d18972edd096a0fdd7baf9d9f03c0d0507ffcd77f58125ff70f50920bfc23c4ef2b5ffba9087ffe8b7fd6051fc04764758040c97705e83306391ffc0200b3ffac1be5f0114193e41f791fb3b050780c65c78ae66b96185a66611666dca2b46016322590fd9aa5fd16fa785b51142ec42c5453f85525879656cc422f66c141215f92310b355fd287af2a69b4f0783ef5ad0db3d048ed854aed24652695d34bca37b5cfcf45c224254be29bb4cc9e506648c625844bfda8a575c9dc8fbefc35e263c29c0a32525a686e247d1178feb7f33063423f6bbe6609f9fb6571cc17331d8b6fea53d597c4326076f42dcc42f3845891967a0a433c1d92c2af933998855c4989a6d04c6dccc2ece8ff48e2f2abfa192ad9b4cc908c4f0bad2f969486490a1fd70f6016ca25a5e56b9419b44fb9d45e2e950df9d440eb92f91b3dfb35e67842df0419292d34be5fc673abfc76ab522229527a29cd39134bd1049950699b5078bb4f61167e48e72534a72e494993ac333e1e7413faca97443a36a17890c68674bd97d05c5247798c9885ca717f46af89b4d12bd44ead64aea4f997df5b0c94072439b346b21ed6484a86c4e748cc82453206736809adf3b6097d984e7d0c8d61391ddf6993f4a183fac3f76194b635503dd609eb0ea8aeaa097dd050390de58da3fde6f780b99885a9b4f07a9be8be55476135d5b113b3f02066612f666113666117666133a57f8bb68d7c03be36ba1ff12505b3700eb3b01fb3f00666e111cc8209b3f00e2dab310b8b28cfd374be3269bd8e961f60160625fbc64b98857be9b8f03edc46cf037594b69f8eb799c2df6216be4d7d49a132bb681f2e62361883d96b27307bed09cc061761f6c655110fc16004668353301b7c1cb3ffe4f9d763365881d9e05ccc067f82d9eb2b317b7d3166830394f61a66af9fc1ecf5a9980d1ec1ec7506b3c126cc0687697900b3c1d398bd7105b3d70e6136b8931616b3c156cc5edb8fd91b3f11db6fbc728bf22166afede04f57e3b46bcb307be363cc0631d51b25fa79ed0266afcb307b3d5f6c870dfc78d2b3173fff6592b51347e3cb865928a6e39546f3713b8dc9507e9e4ac7bb13b3e094ec0d13f7916934468d34061be91c97d202749df3f1183f9edf466f6076f402b5a5964023c50f519845638e8f99bf6016b6d2b937527e0d3dbb45d13519c275b4aec3ec2827d2463ffd3f2f488b591c3b8edf8a67f4b288f371cbe732a15c168b90afae8a65327929fd963cfb279713d6c7fef0f6c978a56d215fc26480fa0ae37e0b747e1e0a69b94aeb19b484ea21786e12ffbe823eb1fc2be6ec5f5984186f09efeb97cdd5d8d85e958cdfd5f1be4921e449ce2153680cebe8bad92fc97d7fa1a599ae031ea6d35893dafe075d3b4accc2b3623ebff10bccdeb88ed96b3fc26c3016b3c107317bed75cc069f127360f05798bdbe17b3d739cc5efd11c55f95f01dc36c5086d9602666affd13b337de15f3115f42394f9a5f85c2dbfd0cb3d79ec36cd081d9e066cc067f49eb7922e4cb7527666f7c8ed96b07c24b700366ff598fd91b7fc2ecb5a7311bbc0fb3d7fb307bd58bd96b1f52bba726f0fc725c9ea78fe9326336384da2ff536af369da9e2fd2f87d81cfc3372e6136781766afedc16cd02099073d850701827e80d14e80e03040700140f02e801b2300413e7ff9e9def80466e10c66613766a18f96f5e3f30207310baff2e72f5e1f666125957b0cb3f0930972ebe87eba99dabf8ba7038c72b010fac12879145f23fcdf007da367a10ffa400703300043b0163ca32f83073c029799fe77c200ac871db00f0ec133701529900a75a0c5e8597419631c870fe0c398c317f117384866103fd94c769103e430e1c869728ebc2dcb94d5cb76c90ec80ecb38d969d947f27cb95ebe40feb0fc49f931f919f979f96fe41fc93f53e81553141ec55ac511c5ab8a37151f293e536629172ab72bf72a0f2adf535e88288ba88ab046b4452c8c581a7122e26cc4eb91d3229b23174435456d893a131d15dd16bd307a69f4e9e873d16fc72c8b198e39135b106b8df5c76e8e7d36f6c5d8d762df8afd20f6625c519c316e5adce6b85d7107e25e8987f898f8f4f88278437c5ffcbef843f1cfc49f8a7f35feb3f8ab098a0455424e425942558235a12d614fc227095f240413a31253134b12cd89f589ad896ca2337120717de28ec47d8987129f493c95f86ae29b89ef255e48bca402558c2a5d55a032a8ea543354f3545d2ab76ab56aab6a8fea51d511d509d559d5ebaa77541fab3e575d4f8a484a4e62920e241d4ee2924e279d4b7a3be9c3647ff2e6e45d290b52ba535e4b8d494d4f2d487d36f5c5d4d7d2cad276a747a46f497f23fdddf44fd2bf480f664465a466e467e833f6647c9059947920f3702697793af39c1aaba3d4596abffa80fab09a539f569f53bf9db534cb977521eb527647f6c6ec91ec53d967b33fcd0ee624e464e5b039a7722137263727b72c777beededcb7723fc8bd987b99c14c1cb39859c60c3317984b79907720ef7abe33ff9c265593afd16b4e695ed5bc59b0abe040c1c5c298c2c6c2ed85af175e2d5214a98a728aca8aaa8a7c451b8b468a638ad38b0b8a0dc575c5cf96e092b892cc92a21263c9b492e6920525dd259e92b525db4bf6961c2c395a72b2e49592374ade2df9a4e48b92606954696a697ea9be744a69536947e9e2d265a5c3a55b4a77973e5cfa64e9b1d233a5e74b7f53fa51e967a557cb1465aab29cb2b2b2aab2f5653bcaf6959d290b964795a796e797ebcb9796ef2d3f587eb4fc64f92be59f965fd1cab4095aa7f6235d814eab73ebfcba3dba4775afeb13f4e9fa02bd537f56ffa9fe4a0554c454b4552ca8585cb1b4627bc5ee8afd15072bce57fcc6d06468336c311c35bc55892beb2a57579eaabc6eec3372c6532685699e6991a9cf3460e2cc59e685e667cc272cd81265596479c672cef27695acaaa9aaad6a61d5feaacbd5f9d5faea85d54bab8f547f541351935063a8a9a9a9af595cb3b4c65773a6e6b59a2bb54ced8cdad5b5c76a2f4e299ad237e5eda959530ba6faa7be3af5f5a9ef4cfdb0aea46e6fddebb7e5dfd676dbc1dbce4d9b31cd3f8d9b76f1f6b2db77dd7ee2f6cbf5f5f5eefa571a540dcd0d27ad0aeb3cabd3eab76eb5eeb31eb69eb0be6a7ddbfab1f592cd6c5b643b68bbda58d438dc78b6f18a7d91fd88fd4c537bd333d3574f3f38fd9519b21933663c3ce38d998a9903332f35cb9acb9ab734ffa6f983e64b2d512d652dcd2d4fb6045bf35b9b5abb5ad7b6ee6b7db6f5f5d6b75bdf9b15354b3fab63967bd6fa594766bd3deb525b725b5d1bdbb6beed68db87b355b367ccde33fbf4ec60bbbbfdf5393067c69c8573cecf796fce8539573aa23a523b4a3acc1d4d1d5d1deeb9abe77e3cf7f2bc8879c9f398790be75d9877693ecc8f99bf71febe05b205a90b76dd61bce309b6843ddff9f1c2813b8d8bb48b0e2eba7ed7c05d5bee3a79d7c5c5598b4b16bb17af5ffccce2d7167fb244b144b164d192d35d655d9c23ddb1d0f1a2e3727763f7aeee377b708fa1e7a453e19ce66c723a9d279cefba325d55ae0ed716d709d7a5a5514b9d4bf72e3dd36bec5dd8bba5f764ef077d097d8d7dfbfbdeea5fbaccb86cd9b243cb3e5e5eb2dcb07c60f97e77a6bbd1ddea5ee81e70ef765f59f1aa27d9d3e6d9ec39e9f9c2abf72ef61ef0be33a01a58367062e08d814f06822b5357ea5736ad5cbc7278e5ee9547569e59f9f6ca8b3eeccbf4197dcdbe6edf6adf1edf11df59df0783b2c1fcc1fa417670e3e0a3fe17873f5f155c5db47aca6affea7dab4fac7e7bf5a535f96b9ad62c5bb367cdc9356faef9646dea5aebdabeb57bd79e5f7b615deabac675cbd6ed5bf7eabacfd7e7ac6f5dbf75fde9f59737646de8db7060c32b1bbed898b9d1bad1bd71ffc6d31b2f6f326f6aded4b5c9b769d7a6839b4e6d7a63d3e5cd399b5b37afdd7c74f3bb77abee6ebe7bd9dd23771fbdfbfcdd17b7446d29dbd2bc65d996912d47b79cdff2f93de9f7d4dfe3bc67d73d27ee79e79ee0d6a2ad6d5b7d5b1fdefacad64fb6256c9bb26df1b6cddb8e6c7b73db17dbb3b6376d5fb67df7f693db3fb817df6bbcb7fbde3df79ebef7c28ee41d4d3b56ef38bae3bdfb22ee33dfd77ddf9efb4edff7c1088ce48f348eb847f68d9c1df96c67d4ce929d0b77fa778eec3cbcf3c59d6fefbcb84bb62b73d7b45d8b776dd9f5c4fd53ee7febfeebbbf77eabee5b67f6a8f6ccdbe3d9b377cf337bdedc73e5dbaddfdefaedcff736ef7df101e681650fec79e0bd7daa7df9fb8cfb3e7ef0dcfecbdf39f29d770fa41e183ef0c481670f7cfa50f243dd0f3df3d0e70f1b1ef63ffccc23058fb81f79edd18847471ebdf858c263f31e3b7a100ece3bf8c4c10fbfabfa2efbdd65df5dffdd670ec121d5a1c5874e1e7aedd095c7cb1eef7afcf0e31f3d7ee989854f9cff5ec7f7767defadc3f987971dfee4fb9eef7ff164d593bb9f3cf303c50f16fde0d523da23eb8f1c3972e2c895a7663cc51e951d3dff34f7f447cf943d73e087c3cf163d7becb983cfbdf75c90cbe21ab96e6e197784fb3890106002d3028b02c38113810f03c163e9c7a61deb3ab6fdd8c963578e2f3cbefa3877fca3133927d8139b4f3c7c823bf1d6894bcfa73e6f7d7ef3f31f3c7fe5249c8c3b19776affa9dfbc90ffc2ee170ebd70f685b75ef8e2852f5e9cf6e2c84bf0d2c197cebf74e974ebe98f5f6e7d79ebcba7cfe49c397ce6cd33577fdcf1e30bf433fc2b680f38611d2800a33d7800ee07c017e35280089ff467a269639feb378a12c2cf3868a438061974509c40362ca0b80c926090e27290c3768a2b40053b29ae844a788ce21110096f503c120ae1b7148f860cb84ef158d0a3148ac74119b20101248b04801d6805c51164a17f501c43044ea43881293883e23228c273292e8728bc86e20a28c0f7505c09ddf807148f001589a47824341335c5a3c1485c148f8545e43b148f8379e4ef4f314683c1a0331a8c06a6d9e5b7fa1cfd9e416650efd37bf54ca36bd8e5f60eb89cccaa7e7f1fe3f4f50fbb1887c7c9ccf4f85d6e777f3333b3d1dea01f979be5f578ad5eb7d3a837182aa7365b1966ea5823dfa6e31bc3d99931feafe4ec1f641c8cdfe770ba56387ccb19efd29b3c1eabb7f7f5bbfb070698b621df90dbddefd1322d5ecfa0dfe1f1f77b18eb90dbe559ee1deef3fb0706a75454ac5ab54abfcce5ef1624f53dde15adfd3d2ecfa0cbc90c799c2e1fe3ef73310d038e9e3e17435bb4cc02976fb0dfeb618c7a03af866a71085c7aafafb7c22d720e56b4ceb4d9dbe6da7546bd019e02068c6010feeb048c2f0c34830bfc60051f38a01f3c30080c0c821e7ca0072fe881814670c130b8c00d5e18001738818155d00f7ee803069ce0837ea19d01077884d699e001bf20e1867e6816288d608706d04f6a6f1678c1035eb08217dce00423e8053f2b612a3483151860046ca264484e3726f965da9949f4ff9febec17ea0e60c02f7038c1052bc0013e580e0c7861e9d718e39bdbdba10ffa85d1e31f4418688321f0c1101d510f68818116c18741f00be3ee17e87c1f792e17786039786118fac00f7e1880419802155001ab84ff7a5826d8ec96d8d4430f786105b4423ff4081a06e96c0f09f3ea029fd0cb3e61ae1b60001cd0436be132bc770b04fe41e817bc64e89887bc09f7c521d1c58f880f7aa142e8e9b8ce41a88056980936b0431bcc05bb10c5bc4e21c70aff461f8499633958faa6f58af07a19038102f80522f073e8861f221992430f3c0b7b90020aa1086ec028389112454031fc178a445150022e148d62e01c9c47b1f06f280ec5432994c115948012910aca51124a062d4a81d7512afc3bbc0157e197280da5a30cd0a14cd0430552a32c940d1ff1e18672502e62602918e14d9407bf825ef835ca471a5400df4685f01c706082bf80192ca8081543152a818fe11a2a4565a81caa9116e9a006de467af80fe883dfa00a644095c8884cc80cb53005595015aa46353015eae0bf512dbc84a6c06d682a7c82ead06d681aba1dd5a306644536d488eca8094d4733d04cd48c5a502b9a85dad06cd48ee6a00e3417cd43f3d10274076251275a88ee448bd05d68315a82ba900375a31ee4442eb414f5a23ed48f96a1e5c88d56200ff2a201b412f9a011fad1202c07372c437ec407cf0a388486c10e4d1044005eb40aad86e97011ad410866c0005a8bd6f1c182d6c34ab4016d449b602634c33fd1667437da022de81eb4155ae1b7e047db601856c110da8eee453bd07d300b8da09dd08676c16c743fda8dbe057f84769883f6a06fa3bde801580d1db006ed8375b01ed6a207d17ef41d781c1d800d1080b9f019cc83f9e821f4302c408fc09fe03a7a14ee438fc11de820fa2eb0b0111d82cd70376c428fa327d0f7d061f47df424fa013a829e4247d1d3e819f443f42c7a0e7128808ea1e3e8047a1e9d443f42a7d00be845f4123a8d5e4667d08fd159f413f40afa297a15fd0cbd867e8ecea15fa0f3e8dfd0ebe8dfd11be897e84df42bf416fa357a1bfd07fa0dfa2d7a07fd0ebd8b7e8fde834e5888de471fa03fa00fe14e58049fa2ff8417d1477017fa23fc197d8cfe048fc141f409fa33ba80fe0b5d44ff8d3e457f419fa1bfa2cfd1dfd017e8efe812fa07ba8cfe075d41ff4457d135741ddd4041348a01238c31c1322cc70aacc411381247e1681c8363711c8ec7093811ab70124ec6293815a7e1749c8133b11a67e16c9c83733183f3703ed6e0025c888b70312ec1a5b80c97632dd6613daec0065c898dd884cdd882ab7035aec1b5780a9e8aebf06d781abe1dd7e3066cc536dc88edb8094fc733f04cdc8c5be011dc0a49900c29900a69900e1990096ac8c2b3701b9e8ddbf11cdc81e7e279783e5e80efc02ceec40bf19d7811be0b2fc64b701776c069b804ff80cb9008f1900d39f034c8e07b900f4fc04fe15e880505ee0615dc0ed110038ba10ba6413d24e01e3806c7c18a9df02afc0c4ec0f370127e044fc15138033f164e412ebc14f7e23edc8f97e1e5d88d57600ff6e201bc12fbf020f6e3213c8c57e1d5780d5e8bd7e1f57803de8837e1cdf86ebc05df83b7e26d783bbe17efc0f7e111bc13efc2f7e3ddf85b780ffe36de8b1fc0fbf083783ffe0e3e801fc20fe347f0a3f8317c107f171fc28fe327f0f7602bbc0c4a7c187f1f9e846df849fc03d88f8fe0a7f051fc347e06e2e0aff0399c0206be0579900b0fc23e78007e000e380c0db017ff103f0b8fc2c3f0107c1f0ee0e720025ec31c0ee063f8383e819fc727f18ff029fc027e11bf844fe397f119fc637c16ff04bf827f8a5fc53fc3afe19fe373f817f83cfe37fc3afe77fc06fe257e13ff0abf857f8ddfc6ff817f837f8bdfc1bfc3efe2dfe3f7f0fbf803fc07fc21fe4ffc11fe23fe18ff097f82ff8c2fe0ffc217f17fe34fe105fc17fc19fe2bfe1cff0d7f81ff8e2fe17fe0cbf87ff015fc4f7c155fc3d7f10d1cc4a30408229810222372a2204a12412249148926312496c49178924012898a249164924252491a4927192493a84916c92639249730248fe4130d292085a488149312524aca4839d1121dd1930a622095c4484cc44c2ca48a54931a524ba690a9a48edc46a691db493d69205662238dc44e9ac8743283cc24cda485b49259a48dcc26ed640ee92073c93c329f2c20771096749285e44eb288dc45169325a48b384837e9214ee2224b492fe923fd6419594edc6405f1102f19202b410391b003b6c34e18815d700ff19141580217600bf1c32b64880c935564355943d69275643dd94036924d6433b99b6c21f790ad641bd94eee253bc87d6484ec24bbc8fd6437f916d943be4df69207c83ef220d94fbe430e9087c8c3e411f228798c1c24df2587c8e3e409f23d72987c9f3c497e408e90a7c851f2347986fc903c4b9e231c099063e43839419e2727c98fc829f2027991bc444e9397c919f2637296fc84bc427e4a5e253f23af919f9373e417e43cf937f23af977f206f9257993fc8abc457e4dde26ff417e437e4bde21bf23efc26e780f6c703ffc01de81ff84dfc3fbf03b78173e800fc9efc97be47df201f903f990fc27f988fc917c4cfe443e217f2617c87f918be4bfc9a7e42fe433f257f239f91bf982fc9d5c22ff2097c9ff902be49fe42ab946ae931b244846652043322c23b2b6f9adad8a866e9f6bd8a56c58e1e8f1793dca066fafd7e35aae6cb03b7a86fcae685b4fbfaf6768c552b76b7594cde9f53b7a7a5c1ebfa2b1c7e1f37a148d3d3eafc3afb00b3a14768118651f6353daa956bba855315de0889e2e513a7d8c5b36a3dbe18b9e31de8667362b668aeecda48a66524533fdfd6ea72bba799c59d12a38ac68154cc85a9d5ebfa24d24b5092462f7f42a660beae4b3fbbc3e4fececbe214fafc337b4c2ed18f22b678b26a2670fba1d837da2640705626fe78a433277dca872c8d35f69afb5f3d05059d3249bd7edf029e689ecf3455bf3055bf3c36ccda7dd992f76473edfd7efe955cc173aa5b8433473c7b899c83b9cfd2e9f6bb07f507147afcf31ec8aee9474bc532029160a62510bc7475f70ca6814a1a98a42ab08cd16115a28bd8af2d91b44d86416a0b1b286c206b1b30d060a8d149a29aca2b086c210bf8d42bb08ad54de4ae5ad54de4ae5ad54de4ae5ad54de4ae56d54de46e56d54de46e56d54de46e56d54de46e51ba97c23956fa4f28d54be91ca3752f9462adf48e5ed54de4ee5ed54de4ee5ed54de4ee5ed54de4ee59ba87c13956ba2724d350a8718eb0e1a1c0eba141d2e31207a24aba6677c293ac56873896bd025ae41d77814b8a83a175527cca6a556d12baec55e89d6def1b5d8c7afc53e4994f50bfa71ff32653f55d84fd762bf10b6a25e5375f4b271a198e5bd3e97cbe376789cfd3d0ab7b898dce20275f30bd423f6cce318f00efa7dde813e97c2232e5697a757e1151790575840deb005e4a58bd52b59ac3e0ac4f11814550f8e7b23777b3dbd83323fbf44fd22d390686148b03014666188f6525c4426ba78aa29b4d14544178f85d2ab289f9d2eb2260b5d3cb5145ae962a8a4d044a185c26a0a6b290cf13752d844170395b752792b95b752792b95b752792b95b752791b95b751791b95b751791b95b751791b95b751f9462adf48e51ba97c23956fa4f28d54be91ca3752793b95b753793b95b753793b95b753793b95b753f9262adf44e59aa85c53ad7288a6d42121a50e89297595180cab242975d5584a5d25a6d43592605f23a6d4b5624a5d3bb62ce40ebdc32d265673a581c24a0a8d148a31603650581be2335368a1ed21b92a0aab29aca1b096c206ca6fa3752b85a17a2385760a9b4468a4768dd40edd08ccc6907f94df48fd3252bf8cd40f23f5cb48fd31527f0c21fdd4be91fa67a47e19427c21bf43fd0dd9a1fe1aa87e03b567a0760c548f31e41fb567a0fe3750ba9df29b683f4db49f26ca67a2fdb484e621d44efd3051bf2c213af587ae71b329a49ff6c744fb63a1fda61ba9d914aad37e99a87f26eab799fa67a676ccd43f73c83fca6fa67ed18dd96ca67ed01c6336537fccd41f4b483fb56fa6fe99a95f96105fc8ef507f4376a8bff40060a639cc6ca1762c548f39e41fb56709cd03ad8b6bd560aa15f59a1a6a156ec78a6ea743ac8afbb7b1b2d212b1d6e5f386d68fd15063a0b092422385260acd145a28aca2b09ac21a0a6b45582d8eb341cc7d068378c03018ac545f35d5574df555537dd5545f35d5575d1be7f5f0fba7cbe376399c2e9fa8464cc14643a541d5b766a0cfe5592202bdbbbfd791114692b66486b7f4fa5c0ebfcb2734c5535ab743ac274dc2a30ea785b525d036b76b705020a44808e3d4544a750cf6f48b5bb5405675fb1c3d2eb76ba97fcc81649eb4dce50f23164e208a45a4f9fa7bfbfca26929d798e92c29df12cfd08a6e976fb0bf571c19d580cbd7ef754a072b44128138b26124694b5a78cb98d1644a5839e41af4f77b45cd8994e85a39e4708ba3d7e3757b3d4b849fe2384908b7228f8b2789843023ea705af8644d54a0120961012192c67a923ee85ad12fd2c63051d6b5bac7ed58b14404620743a4310369528a849e3ce69f643093c688929e8fd1c27a39ce3aae33d731e877f9fa07972fb90911c3638c2ced6fd2185538c589f33d1e254b061c3e7e0d2ef5dfd43216bbe2b84f68a171285a96b449ba26a14a06315d420eeb73a6a4614218174eda3491cbf0555c1305a47e8f8fb3d40fe1e5d7608fd727aee782499b268c619230d0e1739320d2c68da44a08d28015c961412d92c6672f5542b859e344ee1c899f7c5699d0a34c49f384262115578b270063b5b80335d99b9a644eafa757661ff279c5642d9e158c066ba3d2e576ad7079fcd1ae9543fdc30eb7cbd3e38a76adee1ff4bb3cfe7e873bcae3f55396488fd73f38d43de8f247b9bdbdfd3d0efef92592a25eba1d582d31033eef80cb2772c6852afc0f973fc6e7e2cf91b42d5411db9483fd2bfadd0e5fc4e0504f9fbfcf41b7c36a619b361ac5639ed1281e2f8d46f1b868a48f1046a3787c331a8d942e1e578c46336dafb1440e79fa875dbe41875be9f0f9bcab8606c41db8b63a4aa80bd998926a220592d3bbca4329b522850f194aa91229dd5e7f5f2455e814b92d74efb6d0bddb5263523a3ddd6e6fcf725ab750584561b59cb60e48b8c49383d152d344a19dc246a57ba9549b95c2060a6b953ebf540fb5564badd556535843612d8554be96eaabb551d84821b55fdba474fb07fb1c4e975cf8a9742e17a1d06aa3dedaa8353a71964ada1b3a81964a6add44db8d149a427c213aad1ba89c818e69151de32a5ab7507b16da5bf199d368b1503e0bedb585f6c642f55b686f2db47716eabf858e8685daafa2a35445ed54517f2c1456517d55d44e15ed5f55489efa65a17a2cb4dd12f29ffa5b45fda96a885cdaef76bb9cddded54abfafdfd14b03d662ad12eba168b3854686f6d44035194223472d1b4233423d34500f0cd42303edb1817a60a02365a02363a0235349ed5452cf2ba93dba242d95d44e25b54397a8a592eaafa4fa2ba9fe4aaabf92ea37d2fe18a91d23b54397b8852e718b91da31523b46da1f23b567a4f68cd49e91da33527b466acf44ed99a83d13b567a2f64cd49e89daa3a9c5620a4530b567a2f64cd49e89da33517b266acf4ced99a93d33b567a6f6cc74deccd4ae99da3553bb666a97a6368b99da3553bb661a89666adf4ced9ba9fd2a4aafa2f42a4aafa67e5553bfaaa95fd5d42ffaa460a14f0a16faa460a14f0a16faa460a9a67ed1d46da9a67e5553bfaaa9fd6a6abfba49b1a2df33e47729065d3d5e8f53a056d2a8aaacac8ae44f1c2b1c7e97d8626ab25228eab3d295646a12f59aa89fa626f109cdd864a2b05ae5f04fdc32d31d2bf8fdc7e1712e19c38486fc491a26b0248f378c6feaf1fc962d39bca78dd7c34f79a1070697f47161b2878818fa7821110b379130f6fc4199d2258430a3aa105172ee9d8c2f2e9c2775ac1a7ece992896e4f4badd13550db8870697f03f4496b1ea382d51a84a85524563131f0ed2e8e16be2993e61e2a12c55429090d3a5e4b071b9999419460a6b4a169b263c4f8ac48967bca49002c97ca9c36961ba33426d3785812ad42279e009912447e13052b8db93f5453d8118f6903eb16ddc4cd6c4a6b0b8104646ba062404293d594a1f0b5e2951aa3645da3036eef102757c4c72843a0d919b4daa84e6b0937e661829cc64acd014f22c31541ba3a4482993506f1e157185483a3ac992c9171a240f2e3777234e60195b41a963d5f02767712cc68371bc3ec1d9892b257b22354c6f9aa4352cc224f4b10912472d2c2584af9c94b1eacdb1316907c2c593e9f4857d8c93358118663f7762e3046171d8c61fc8d2c6ebe1594aa2421a6252cde2da4dbb852799b776227fd2a649ec8b9fcf4b0649d8ede82e6830d2cffd8ce28b438351fce0d160b4d5c6f679bdcb1dddde61578f7745b72855698d717afddd2eb777d538917e046ea21f7d9b4c2158a30a53a1ef710cba62432f10845adcd83b02a11a25bc401051e1258280264adf300994f8f1970e22b3f05e4a44857753021ae9ebf7f48a4471dc78345a7c4125e031d46dfda0c3eda73a06436f2a0ce279d76e109f2cec868610aca4d028422ba55b29dd1aa29bc8dc769be88ff8464440852ec609e8d8e8882d829392ae094292ba2099345e1f1397f0083a22d7ba7c5ea3dee1f6c78f5b17076edc05a19e14ee8784677cc4922778349138ae2d7d12df26728feb0540804647217ee2ef547ee9bf0db00156c00afc2e002e02186dc51f8f5ec686d1cba3ad902efec71f03e07771d1e835be751c0f937d57227d83cacf87f9d00aad68eae85e641fdd11aa9369e8413203ede440cb70309fb577324ccb29889bd3c229e62e6439b39a2be9ec5aca8ccc67395ce878210222a0a747d3adcecbe3a093039ba6f11820b07559751cd2724cd7521d87b59a3c4d9e8e235ac6799c24a780d5c625d998ae2e6b0027dbac814262e3b06dde6a868bd170d86673383959fbea6318635b9795cb7365e5f1d4637129c89ac570d8a6b11e4b4249b62eab868376d6d5792c1561c1a04ccb91722ec5c6f2f6b8549b8d32a81927c39d6de764450b8f95a0589bbdc7ce29ec6c1e470a3b3bee64f33479ea1196e1dadbd93caebe53cd70353c56d3d9c904446e87932b6967f3688de10c7cbb81e73cdbce324b99911107c345b5b35d6a8663f8b6281eabe2b1aa2e75576767a79ac3855c8cad87830e9603e1d6ee3c2ec6267ec75e1e97d3e23895003d3cc729397477763a1d9d1c2aefeca43de8649c5caa4d63edd471722d63673859a1c3c9704a5b3bcb2935562e426355e7e57572a84bc72984e1e64839e30c28bbad0cdfc877572dbacfffe4e45df61e4e5e96c7701136668419e15079c0202fe4644573d8ae76b5a3a393d574e675325cfd5c9643e56a7e5ca82b3a4ea9e5226de5c7008bd31ca1e52235560dc381c6eae070f7520ef570a88b5396e9b8482dc37b1b67eb3925836e86d7c0d57775f22c5d8d82b751da63917160b35bcbf2c602275a1b1e4831a21654aee1c0c6c90abb18fb88c6c14faa30d8a0e6278463d45cfdd88071a450e368144dc4de429c2b686779e1fac984e2b442878ec7c600b1b7b3796a4d5e67599e8e8bd70630b6734e47a38e4bd072a88b61b8785b33af80e1e235d64e2e81af75b00c9720cc57a296e1128441614ec9a06744e3e0126d5dcc4817c3256aac1a1da7d2b6cc6303326763670117ebd2acd67149da96396ccb5c91a8ceeb2ce092047ab236002adb7c36a052d938e4b07289e5fc92e370a13510cfff48c085560ea56a188e14b6b3017ef83859a1756484e1cd2694e56938e408e16ab19d17c18502a5938bb74de7126cd3bb381c3e59b798c2004092a69143360ea61d430809b395a2850060fb3c965369ac8c9d8bd358b9580d27efb2325cb2c6ca7471c8f1a3f4740489900456ab951f89645b17df16488e28e77696abf33b755caa360029e53a2e4d1b403c4cd706300f33b401c2c34c6d40c643b53620e7619636a0e061b636a0e4618e3610c1c35c6d209287e55a4d6822384557cb3c56c3e8397417bf6c749c56d2983ad6b8526cd4491a8bc61a7d6223a3052ebefc561de6fbfabcd857bea3d2fee56903c094ebb87c6d00f150a30d601e166803848785da808c8745da809c87c5da80828725da809287a5da40040fcbb481481eeab54c9d10b9155aa68b4bef626c1a0e75d984b9455d9c9e0f5e8396ab28e72aca745ca59661a633b798568da346c367f82fe550f3bd3786e63a10a7b0f3a1c7559605e428c5ce1a3a855e9a24c3732b1eb396b1089e5bb44079ec37dbe450f9a4bef074483d21fee9d8344d4dc08c52f8be5669993a66fa2dfce7c0e6a8d171d55a7d5a9d8eabf92a560ed97a6a745cad368021b590d133d3f9dcc0e1c2992323d335d3350e86ed56f3e957633d5683504a72998e9ba2e520954bd358395921272b14d8023160e5a26de5ae11bd8661ea466a74dcd47036462feae3141a6b889be1baf8e4523f873d2e63e48cfab8ac489ed969e5536e948d19d108129aa62e4e619bb86ebbf8b4276e4f325b9753c3c96d0e673bcbc96c0e3527b775f1296fa28c43c3309cac48d3e4a8516bb8285b13bf7545d9042b5dcc644634627255d8baf8c990173a38f94d5a395911ef4421ef0429ec72d2943a6eab53c7d585c68261184e5e44c7425357a3e36e1b6be2a284f626cd74de283f8bd3c68690ef8c38d21ccc63f54c9d264fd8782991e1fda253c1290a3979e14ce921469cc4c9a29dce96860ff9db259ed842d3d5c59f7426763934c5f55a0da3e747b1894bb3b1edea8e4e96a9ebd4070c28b95cc73584b576a8dbc35aad93ca7e99844dcb4d29ff32838d5a6e6af908c3d4f1313652736b564e61d37386721d6717baccc7679138f20e2e466315bbce07a886a963f49a1aaabf491b8892155a4322df30a4a7ffaba298ef139fc7ea3435ea3c49bce475523fa76b0330a53c342a33b401985a9ea7a1e3427b33360433b51ca488cbfe18f02b3c49cf5595e9b8e65bd05bb40140c9495c75998e6bd572b5653a6e163f8a760da3679a46348ed068b569f980e66695ebb8d9da63004de53aae5d7b0c108fccd11e4302a5437b0c0994b93ccff4721d378fe7e191f93c0f8f2ce07978e40eed7100b095eb38567b9c3f4395ebb84eed7124d2166a8f23917627cf87786c11cf276077f17c02b698e713b025bc4d7bb98eebe26df28883b7c923ddbc4d1ee9e1796694eb3827cfc3232e9e874796f23c3cd22bf8d558aee3fa04bf78ac5ff08bc796097ef1d872c12f1e730b7ef1d80ac12f1ef3087ef198571b80bab1091c106a5c7db98e5b29a20de53acec70fba50b396ebb8416d00511ebf88f23c43020fa23cc3da00dc36a67595501324568b282fb1464479f6b5da00a20ceb449467582fa23cc3066d00a68de9db28d404f64d22cab36f16519efd6e6d0051862d22ca33dc23a23cc3566d006e1fd3b74da809ecdb459467bf574479f61dda00a20cf78928cf3022a23cc34eedb168e188cb29d4c76498d8594d9e3aafb3d35ace45b83852d0be3ab459ebf82db6e5149ceb600308edeee49098390702a0b49e0468a8cc9343195fa98f699335c9aa228a22d295f2284aeac0cd789a42afc8950ba418eb99a4b3896763cf469e55d4831ca2cb0290683d03f563ff79da0b40001a030568c71c96abdfc1f2756763a084af9f8a0091008d9dea40314f7a3162332059fd8e9e79a106e11b7062daf10c5ca3285164cae57165a7d0e8364e767f0043e371b953018d8da19b6601fe7f010000ffff427da2a7142d0200",
 		"dea7629b123b27c28d5c97f51924bcdf": "1f8b08000000000000ffcc56518fe3280c7ebf5f91d36aa5bd53a968677775827f722f23425ce21b822342a69d46fdef2792d026934cb7f3b64f2536fe6c63beaf6cebe658770536b5556fc2910359538301c909953764db00f2884528c58ef3afb204346518d6160e417019a8165cd22bf883a5a328b128c04956352c50ab4ba6748fd6632f2d6786ae8093d8fde05cb223e42f18588053600d9e81a9e2bfb619f3256faef4cb416960afd8608e16c35bca4a6db0e8a087bef4bd657f77d7383a45507446e4e40bf0d12257ad632c56a6abd4890d077003654c39ac548067aa95c6f0d68dbf62cbf94e1ed15aa64be50c88d1716bcd2b379eefe8ca9e9e9eaa26d36d8e9ae57046f0dfb6df377cb3ddef37bbbfe4a723528d5483bb8e36b7a45f92e74c5431652d1da1c87adbf373ec55b7be212f52adfd367432992b3abfb78d9f53602818ba3ba8c6ab7c063935c475022bbc32069df905568ece2cf0a6c6f47d197172d3c52b643cb5ae105f38e7328d8fcfe774205f897e6555807fbff1711a2bf65f5fce5bfacdb86ab4276bd9d1abba5bb26e46af750a5e5680de11316dd1e48242073e25ef27d7473c4ad50f4bf47d41a9d49c42a04af00f33c739a6e36a1bf0ac010b3a0c49fa09ae589ba571b92bcd4ed5ac4453da5817d364c90fd3aa9507176efbfa167524421b26f49e1ece5d355c5c16461e0d3a110f260b540f32f8a1f74edc522e16c93e211c8fc64c28f29b88d828b4534d49045a754eaef5b2d8e162cfbf1f21c8c3dcc6005597e89088f0febff172e3c1661a775f022651dd7005551b28a9415c4f7630565ba5a1245b80ff4cfdef4219cbad722f33bddceff7693808d9a2aa488c3fb1aac907e5c2b4be8975ad31f09e3cab1a73ff207ef0af530af64f0565d138a1c105f0f2406e783c88ddf7fa24e37b8025cdfc599f64a5bc41c72216fba73ec9411fbe68ad179564aabb7987540568f2aaafae7505f8087fd96ab25186905c37c00b9ec596b39fb1846b3f1eac0af83a0bc80e685a0fd7b8155fa6e67fe39316b93c5852a1178ed5c8fe0db338d02bcfdb10c8a55bd8e26d955d578c1d30cc9e88b7415efef83f0000ffff53aabe18410a0000",
-		"ecdcde05099f2b2012a1f4af80e03a32": "1f8b08000000000000ffbcfd0b77db36d2380e7f158b4f7f5c201ad152d2ec6e29c33a692e4d367192c64e9bac578f0e2d41161b0a5448c8b662f2bbbf6706e05554da7dce7bfee7b40e85fb653037cc0c7acbad9aeb30564cf2fbe2fb48b398df874ba62ee3294fa4de26ea08bf3d79b789139d8e6f82e428149824ee433f86c8ef8dc066faf7793eb69524569a0751c4c2a22e84507d6b0ea11789deb04acbb16d25eef3b1f6d64282f6e64281f616a21a2a2888f9bdf662fce459f6eeea0f39d7de422e4325df27f146267a47c5eea5daae65125c45d2ef0de15a6a3fce790eda4b447deace5699da0ba727f46e23e3e5d1f96e7d1547ae6bfef5747cae93505d5f04d7ae7ba8c7fdb2707f13445be93b67f1621b4927e770a8b2339bc9d4162baaf58666b8ba317dda9d91ab5c9749a199e41cfee9aa62abe4385cb21f31d789a92b47147392ae8bff79554f55255cf858d8c1cd131968c9d4368a3836a7bd84c587861e83b390cb601b69a7bde2661632e7f0900694d2ba548b2cf9324e18c1d351a88e24d7de82c51042395dcdef4b68d2d3dcbb0ad582c60521e705a0c5b846aab1a5068e5ab39d9425aa563d3bf6dcefc82c4119c7a5c0091c501c147617d7b7a41aa55da24d12eb1827e9ad82f4ddad2a16cb1c07ac806d6c8473ec8066da4b85b35eec7e7378ceee9d7f5efc74ecf8f5d9f4eaeb61a656ef1d41c2d9a6f208d777ae1db39910e2d6d56685a91a94b88f826fbba75190a6be839f511c2c1cc0bf72d14a96362354d7ad1cdc47d82412bf6b5936c50199247152cba0df0e045b1da7e13799d6b2ca3407d264fe44ebc47716810e066932a7a454ea666a2ab50354a59e6e9a5887ea3cfc26fd1f8730dfa63a5e9fc94518f8f739842ad40895f26ef32298eb38f147de6358bd08e6bef74f9ae659bc90fe43fa7c192e165261f1249c5f846b196fb53f04bd4a62ad23f94c46c1ce1f3d7c9c8f118435826f28a487f339a7f9c56a195e6799494aeb49f739286e6a64190b2ff554a84b3de539e3d0d359d6d3deb5d4cf23b9964aa73f9bad7a1bac8bd37a4f13a96d6c0ef3e5b51f828acfb71bc4a38838080812a1bd453cdf624bb6410884f45e5e9cbd791fcef53691457224a4172c16cf6fa4d26fc2544b251373dc248754482f95daae036c85f412f9752b53fd4485eb00c7f12209d632cb52985799af16917c1a44d15530ff020b71fcbf1bd3e70fc7216cc4a56340c5b1b041c010aa79b425b8736635289cc252dce7b0164f9224d8d5ced7324e9e07f315acbacfe3f2524fb38c2d6991e5edd10779fdfc6ec31cf69fffa4d9ff72a7affbe6fb07ee700e58ced332d54ce21e207485575b2d9933c74d707896390e775d2c97c3acd5e78afec13d4f3beac277dbf47412ae19ef3b474e1fb1c34dab6d3af563a684e9842366fb3ff492c84d14cc2522b32387f31c76653f479269435b0d818f274e1b1e1cdf49e43abe91cdd471ecba5499c3a6d810c6ea084c5f8653225d39f679dda4e6212410985e2351101fea8139f48f53627a3a30e23e47ce2154a90ed45c8a18220f8f84a9a2a097402fe010790ba983301221486f11a69b40cf57a64cc421cae1b61a06a150c29ae35ee0ba2c16d2b3b0ba0ca328cb426fb3e413a65c57796932775d73486bcb6cd112775dddda980261514d0e31bb4fa444d21868a292d29e741f818a73bfea0429433217a6620e779d30ceaea57e1aaf375b2d17e77a17495c54a4dc88693801ead51eb940a4a58442588d97cb54eadfc3855e8dd549e85904eabada757bd29b95f8cb14e14ae87a1dd0427b9b20914abf8d17b2d82995c353c196525c4e614d7f57522c25cca4689124295692902815f022a9aef56ab296fe52c242227bb891a2371a1759632ebd74152e35e38c8fb1c428871bd9629116d2757b6a22bd60b389764cafc21482e49a3061ca7db692de669bae10b96d6496b10df5c4b4b722c43f49fd2d6733c9115e6f7015d217d1365d8999841bc9e17937bad17516e32993bcc156100116341425cac18c9fb23a952e476c4f4b0e177b9c8d8618c2fa3a6a413c91643c87a47ea28b4e95a7e25bc607f1589ffcf4d32465127efa69a0b9cfe659167216f292d7a9351b17f5406719d3226509fcf4130dea95605fc5f1ff86eb6b44e67fe0e7122900fefa4538b14ae7491c450eb275aedb3b66d791cc4275cdaf627d6c50ac0a6ec2eb40c789b74d65f2e45a2acde1b318c26f62089fc460043f3466fedb6000ac27b3ecb7936196f5a4a783e45a6aeebaec3731e439fcda285e00e2368a8478edbaecb570cce63a42dc31ed5dc58b1d3837611a5e8551a87748005e67598fd58bc91a6c370bbb6ea35caba51cfed58248cb94090981f895494e30fffb4028f8b92f14bcc4af8f7da1c688804211da43f69ebae7ae1bf68419337d2663ce02c1ee58084ebc09e6d46b968df8e91047466389a4d313a6c48d4c96517c8ba366b1081177fd1c6f9561ec42a9f40739d78c43203e9ec65e2497da755f9ec45e125eafb4ebfe7c1a7b3ade0c46aefbfb49ec5dc55ac7ebfea8c4cb410e72ff608382002248610b7358c00696b08615cc44ec15380f5954f641845ec17e71d7fdede49f24daccec81e705ba1ac2a77e7fac4ee458f5fb3c5cb2d9a59aba6e0fff3178ea43309798d1fb25cb626f93c8758038fca3ba9161e4ba7b49d402e791341f6319a5f20887b410d46813c5139b29ef36815a384881d9568c1e2c7896b1adf8cc6199652cf6660bb97c7e2796a247526da016483dccd7c9689278735aef971257f6f4f170e8ba451ae1534c9a3c1e0efd47ff18fa45455889d0434e155e9b93fef984adc5f201e51b6696166ee4ba9f4e1fbaee07fcd32b9119fb2cd6f0490cb9ff597c38a542232cfef7c9d21f72d8f484d8ba2e7b2b42a56442c3e86f1face0df26c18cb5bf85b9188c1e6c6123b60829c50a750212fb5904164ef8a9405af6bb081086f889f8b7ebb28f2230c085b90f56aecb5e8a80008f9f88b7aecb7eceb28f59f632cb7ec79536006298f22cfbd5ee9bebb217388f47aedb5bb82efb70f228cb3e9dfcc8b3ec5f5404b69c93b85cec30a488e67f3bfd895f2532f892e386f752d77de1babd089bfad1753fe11f5a41f6ee723825e26fc49a274b2d933771b0e0651ef55b8d35cb1c14679c5e07fc845e29b2701a7b244c1b06f622d7c5914492458860b5ec42fb62088908bd8618028108bd4a4a81a849197a23a8f03851882dee4770fae34f756a356711dc6b2be8043987a0271aedba2e6b76c473ff798374a538720ebd21dfa3257606e198219d1542e041678178f4a8a02d487d43910c5849aa383f19122a1c729059169efc34d932eea76c8bc27f9e332939a88e65120569186b83169e06f3959c2c6424b53caaa7f9ec07e400664c8381302b0173b829930ad9970312e55072b8661aea223262fb588ae74c490e617340b164f766307e31aa1c8974d7ee2a21f720a6947ef99869117a3599f6b2cde9137e5a639643524833d7664c3b440793059a83dacf2cd9579e4380b3644d66126248cc0c10d923aadfc072cc36e21a89222ed3955cc689dc12c6c55e78a174799f48e4c7e50231a7eb3235993109a1d7d412707f6f4846d48f3927b1f5f09a91b0bb9f6df212d76573c15098ad683c77dd85614fb69e8a17f22d49b50e0987427bcb30912962802cc385b1ec0d8bb22cc8b239878d28773b073399260c2db1d34806893d44ec0d873722653fc0c3c7c321c2988450e219e23077ddb5d1186d9b1a818be01ac7c59c34de2673e970482487687268eb22ee07aedb432cfc4721de1693e3932627ab93ddbdf4e6b1d24491d422bef5a2784e327e293e6a9ecf519c620a19569451349e470868af92b908ccf246b828ae7bcb24dca7c91cf10a0759d16ad7b587b296063766d90a25158726835c1cf179bcde605d845885343d880c011d8d9748fc10abb8ee8c80301d84e9608e277ee120ce609b7218840710fba48d5e9c2ad36e7273a4949373f8897303e48e10b2d86bd7fd6d30309810271c350f3bf227b5f956a7ffebdede20e6465ee83021d93fe7e670700e8930b4488878cc7a89ebbec8b29e22ce794f7445c6b027edd1c1368bd5cdb2156d47a5cfe359d65bb5b60891b9ae0ebc39ea890c160eb7523882c3136fbb59a06c1fc93543188786f4d9800c22d3fd3e0492b04c023128123c52292e1a3b55f18ee2116824709cc3b6c18d3e12350eb3e426a8ca430e2911c5b96cca4d2fb2ac2445ef4f7e2a85a69fb8cf5e984b8b56bf1031c74a3db0354798e7703fab8b80ef4b4a5cf1c0e290be8f354ec1bbbf548e144825c362abd606a6696098924884947aca26b896e92abe7580b5e0557a1b99a461aae5a250406befeb5626bb731949643f9f4411733cecba81efc6ca32f1aeab0ad590eb6e1b1ba8ba7546f5131e4994a5b9118809859c6d35a1a47757a94c6e643251f2f6a89dc8b4e45e6c7eb004eee7ab305abc09535203a7db2b9d48d2c004c55948e9cac567c99e2a9439cfde9d218178a5529968b92817ee40593c5f67f1225c86b5b2a9d4af9496c94d10316da089567e15a4abf92a50d7d57e5c3acb78be4d1d70d6f1369528c139e0cca370fec5814273aa9340a5214e592afc1d14da58fc393da08bdb1fafb49de2d21e2f7ec8fe776e4574ede121de9deb404b3e994bc67d1631dbfb5c72e8680c27ffd4908f37f60a414b0e299b4b78287fe475d02fb43d4ce2f9795aaa5918e7be36c77225e75f10e6535f4b3088c58f24cc8237e76ffc2da2e027829d75b12596e584048231c1704b95256238c458f485b3b973382c8a6568b202288e266208816061fb54eed1665ee8ad9293609ca0e07a994c0f31346365f1a587cc1c96c832a49e45326dd1b33d755ea95ba86be242e4a8c415a26a501c58dce6c86caff7b7b81abe82a24bbfd7d3793797a6445c0c906a71d7553da47cadb575dd33ead7606df8d6c2d9463da0c597626dc225d3b4ac520cc7f2448f65bfcf9fb12f97724a536e62d12fdf43852de6b18eeabe3501ea1b54f4c87f9673386f92809ef442d73dd415d1e51029c1136fc638bcc2bfdcdcbb7c81337806dfe8fb1dbc8037f001dec35bf837fc0e2fe123fc0cafe12bfc01bfc067f80d3ec10ff02bfc0b249d432521261e309110488824a412e9c9dc5cd22e246c242c25ac25ac24903eb210b7d216610c55a85df7dc90c458dc9b6b225ca0f3f09b4cfc27e6d62ff15f99cbb173d8bef76f2178eacf2079eadfc0eaa9bf02e479fd6bb8feddbf82e4c90bff699e330dd5c5123c43ec30d6d5e59750208b2b7d5a26fb2d14cf59d76dfb2df19913f38f7f8f1292f3d3af17d7f56bd006a48f6fe270718482240b8553142aafba595c27ff8dbbd13dc9b4bc5d81d85c4574a9ace00676700db7467b7b8742f5156efd53fcf35cdc5f05494ad78ef73adef83ffe0846f5e11b0e2c877914a7f27944c02353ffd209b55c233e0f36347070bec5f17a709b041b079c6d88b83dde0cae82c499024ae517f1ab4524fd1fe5a3dacf775b9d860be98fe4a3e29af6955a84f340c789bda1948f20582c9e9a6e5e5e9cbd79a1fc4ed5b5f474a8233961da2332994875399c7a46017471f646d8024827fc83851c077a235ece1789ab9da2f9b1dc46513a4fa4b4bf71d6e62b5d05495123de2291343f8224896fcda7656a8a1c1d6c2ee2a7d891df1b995f17f1f5752491fa247194525b48306db1b7b1fa771caf0beb04eaf1e7add6b14afdcbfb70e13bcb602eafe2f88b0351702523df39c73247b13a7a51e66c93c877565a6f52fff8f8f6f6d62b2a21bf724c8d26f61f6fb3da4cb6e2fe7e9b4479eee4409de8db506ba4e6b68f8b5b89425aa35d5b869a0c89961e6b2c36d1f24e8bfb7bfc27cfdd6d12b55bdf60f144a6ba6cff7da88ec27a0776dc6549ea6513aa6373f5767c456b723ca9b5ee928a40dcdf87ebe05ace4cda42a6f324a4dd2d87540c6311df2a6b586046f1cc261c510b7634f7f749703babb5e940511139b1295c4bfd0a733f7e78f3224e6837ba0c35bcf936495e69b946090669748e35dfff69c5db967cbb4ae492aa5ec83bfdd73aa44361ba0cd542de9507f05c6e7ce7e8f8c88165a80b9024e2e88f1e0e87ed7b9d70c96eec057f6f38964266991d1d515f9879d5b977dd99475ce1c7542e8c60ff82990b8512930956e89be8423a995b12c60f5f0f432286e3e4e46dc9b0f4fb9c29f1f63299722f5617c1c67591a82ce4ddbb257336e9ed663673faca5328ab9e0e46c870988228e088de908f63c2ffa98e37ef9378135cd33a9392a999c438dc90145e5a19341906d11be5c86e2e25a9ef532f4cdf458b276a91c4e162f2f7e1d07f34444ee3d5bea5d8253357d9e5edb5c3fb0e6162678a0c51390f9ec393fddbc9911062868bf676bbc61d4f5f28c6c7b227c4ca75d92b1623be1e0c62250769142ea40392c34a489ed7f809c6ef5fb1141cc20b8375bc08a2c1c05e1fc1539ec317b10765eca9e83de51362c271d834646cc40cb8d1d43258c841a81c7e68019f5a3e001e0d879cfbec9c1d2e9a65c4d6ffa5fea845cee169963d631c7aa31ccec4beb912d34237019a97d0a96bd059da0e7ae90ac76516ec4da8be3c35920f5919f458cf9cd52cc38f20ad2b410bc4c35d97d90ee38d54ccd4b0b3a1661db06231526fb1932910a388f4817ee938c62ca162283004a513e72b1e3f1ec28a6e46c48f0f87a0e38d180d8710c9a5164ebfe8da10bbc959a0575e126fd5829914c33e1f3f1c3cfcc763ee8f86760dbf300e2384e3677578280e76a16f0d457958675e9d8cd54fae3650db469f74b156af83a71b9429fcbe5d168f31e5d4112226877df1b793e0089754387feb4b6f9b44a57ad029681248358f17f2e387574fe3f506c552cd14e7f5820de4df515c378befd10bddc8b644a8aba198f3fedf9c230375c2995d4581fae21c11fe130d181fe07cc245ff6fcedffa4c7a05444d2ad8f21dc421a74e5f7a44defacec97170eac00ce5af549e57cbfb8e2e4e42d199832212e7e3f400331542332756c4cd883314a81ab6bb05e217c3b13a99794d6eb3000a7b69aaf1c098b35d437eed4a74bf5690a31cde8921bca8836443877dcda9c0dc756f51b04512c5880d7cd37139c3f6681bee601468b9b828e9958e0b84a05dd7c1d5707a42d4e570d61ec1b53880cf6a631a22beaa5352cb41e389fbd05c52946a09bf6fc02919cfc12098ebf0463ad0931c9684e4df57f5ac7c1208e5ddfc126cc646d31b855f64b4bb88b7f3d5337913ce6596d5c87796d511c2e9cc6b730b8565d4cc2b848cf1cc2b996ad72d94bc22b2f7ad139664196349654b1515c4da563b6afc426cfe851415c16623d5e229821c3b5879369b4b641c1dd2e484a40efb99f4092c81d0aa840c7cc5057c21815c861aab14b7c262e6b50513a62041f1624247e595d2ac795b0ea321cfb21f7ff423ba48f6cba6f69760e8179f40b7ce82aad075ef9149282b0f73782b2eef9187f12b792ca67fcb84e79103b17ad530bc44a64dc83c075bb74620abfa56ace9ac9d0a9903b14b0de506e3559b86151f0c2cbdfa2bad467fbd5594beaa468d2ce6d8ba780651987a26d32f3adea0e054d6b7025a6d950a89ad7344417d958abe09ddd45a30d8a7ea9d12f66a913438182091adea5a11b1aabb49e4cda1aa6474f09dba0a2948bbee32ad6ad44558677fa19517a62fca228c4f9427ef42cdb8af3c3a37f58d28114bd57c4dc8ed5ccc0d2ee6747c4bca9d36b71a8cb527efb4540b263dd3620acf499f3c13554a6cf46774d0ad460b9974e2857e4f824d756c1d0e6c211087a55a2ace9c58fd26131dce83e859125cb76e0aae5c579e783f3d9edc7aab7051cae18cfb3dcc3a15de4f8f8946ace2db2a33e71c16d8f2fb50cd574f0d5c74b53b61ed76214021832e37a903efa783cd2374ff2253327956a495aed3081688de889b660e346074a64fad96fed6de6551d622de5e45f222d8d41b2d79df9ab0887b1606111ea637f2464663ba8a2b7f32de13424da48783bd8899020d8f1e3de27e9942bcd8b3a23bacc87a23a87ae0b6861df3c6a86e71ab8c252db04e859ae89615517a8bbbe54514e1c800bb140789a239560c3c50ab2a5d9213879f0eb3ec989d9f3d79f3263bbff8f0eeed2fd9f3337e1c1add7bec69a351a74b46e5d929e176151b132a73059136f7541bf3754b842e82cd91bd3db94022654cdbf7809e36f1d68bd52f517c15441701dd147790f0ce166a5735d8c619fe7c77239362a85b7568b096f7dfb92ef135e52dd10ec76aaab152e16afbd96a07de1cce2751175ed40a1c580a9bfbd716e340f18333074576cced212ac3fabd2e0f52853339b471a8ebb2199dfcd2dbe0d93631f2d8100afc8a420cd93ddbb55ec85427f1aeb9cc0dce892508de56b826a627c1f9d5a5ed109c16af24d71b632a9aba2eebe6cf6914ad861aac10ae93258087f925ab1de0d0e4a93932b088a0f6d60261f26097656b0be6d4b0d02bd55e9fcea65df72f346dd7bdd6fabbad6e1fc9efcfb5405bc8ff1574e62c48ae43e5c07b0e1d06a661cdded93a2ec6a5575922622bfc402086e3e0241907fd3ebf572884c497c1947bf3e23aa7d49e4562388e2a2558d4ef73b5aff462a1787b194d795df735bb0c2d899db6b536a5a4656f3e07834598065711d9a3849ea1f3ae5b7c31c9b95f5bacef55e7793e4e585c42221f1b19649fc4974b5e5c2df071e0ba090baaba3933e045eca5eb46ae8b60fe14a92c3c61bca5855c58ac8f724c27ee3d8c91be87d076a27e4f5e6ff6a110fdfebb8698b927d21d3fb487a4cea891356ca7ee10890a5d6cdee27a5558e7c9fb5784537027ff0bd4b59fc61a475c7a5aae3728ed9acd180c52e3b395229349bbde809b3f290e33afc630ba2efb802bb2cfaeb043a2fb9ac3fa90e05cb11548ed4b26c6de344d50b60da228be7d9fc4d7894cd3f046be5a5f33b249aa589ef5359916d5131af65adc753f2066f33f949bd9799755e29775702ddfc4c1e2a9350f69305da0eae31642286a9e6c1b785ee1d286b03f17124a456eb88848879b977b59e7b2afea4b810ca929f2aaa1faa7835227341db26ed50a0acdaf90d2b4680cf4dad70c9cc31d31bc740169598627ac36d40aec1a583176dd837b9c4a7d4e84fc1d9962b12110e630699f19c72d793ce4a02fd99e6c7358adbe07b708aed5389beb259a14ba10265d97058d0b484468db24914a537dc6fba3feccdbbb76e9efa9eba9e71a1bb3a7b289857187ead650ab3a4f1c2e59ef86874b56d864b8aee57d11da0aeb884d4cb76b17bb8db9dbf9c6e2429f764477d756c2657c5c53c895183a5c5f93027b248468cb080881cd9493ba8cb10cf50724da939977e0f29340d874de25e69713f31219c92095ef712e9ce78577c4ccebb86c755d76b52ff7b5652942d5e5bd2d718607269f65b464dd6b963799ccc661debf3aabee682abe0bbe1dba1fa3c6ebd310ff05030357e48ed69c76037f88de10eeb2ac3808ecbb8c62c917618b16f1771df1e2aeaaa051f61aa5d723db8bb046d670b2ebf8dbd340cd6584c9e765f2adbcfa12eae7fbc5d3661a8da64d2bdb63d1a0cad1ecb920c7a2a645b1766fa5e360e13c5c353fd1e29e5427af11d5b4321dc009bef69de63c9dc2adf275536343690e180aeec7b9af70393e54ad9eef75d9955ff6dab196f5aed7f1b72aa3dd3be63a7d1a8359fb0fdf9bf98122e548bab6af3e1493ffe2e05ad8027640eb74af27b2f1ad5665bf40b528e9e161acd3ef0ce1ecbccab4ac4bce41139ea08eeb60162ed956d87b8b77cad02b68fd463a7970e57a82cc794ccbaf7965086349d7652d77caf8f8508e9d86f7e4cd9b77bfcf5e3ffffcf3bb271f9ecd5ebd7dfff182023de0b2ecdfef1eb5c7ba85e2a0d8f67135a78c9a080f9c7bdb54aba25ded292e5e9ee77c121b3f020d0a3448eec73ccb6a765921cfe197f91ff19eb9d57e5809a198737e16af506e11ca43f602124c247b2d0e01252648d682c308aacb78781d6fa3c13c8e302d8cd5d132bcde26d2c175ef30646d982ea5fb7e30e1c201dd2781796c2e9ef63473107f773c88861d7e399c42d275196baf622181404888c87b8d44cd545c4e612b86e3ed4934de9a4b37a4e34c8be0728b2263bc90863120b6a3614bd536cfa0b92cc2b5542929cbbc7413859a39770e2d7b57e9791cc5096d0ff95cb4cbac12b97438dcfae52d4b8c731c0d39acea6923937675fd14dbf31384c262a076aaa72332a627deb43e8d51ed16d3acfebc7ba86b723d18871e7e8839725a9d830d3d19090da9f1d90e795e183ce68c8f9912f7d7525facb6eb2bf2454c1b366ec5bd637229a79e8c0e58eb06b4d3f59d00252c17b109aee567c31d67d921a266b56117f1a650af77f9455a2a777fe71b2f5bd8f9e459db5770ebc7e60e30cf2b63b851cb626e545acc8d2a1bbb51652437aa1bcf8de0eafa9df10df647b955be8a728be93e6d3082307d1bbc6556c7c1b34cc9dba3d00b580c811740028a93de9af17c5cd853285a6c1584514a76190d06aceb82b750441b8be2896c2510236a96e6b720da92bf7f33ce8d894e5139daa3d8ce349f685fb27afc01b2373ec0da352f152a746f60c0759d17af7ef9f8e1b9433cb74df574fc71b391c9d32035ae25c8ff9742432750878b2a608546f4d81be5796b3a2542dcb3400bd295976eaf4ca82436e2a0c57d6eecd7cda13b795c502a3d2e2e182c5238ae757c89303c15781841e33151de6615ebc392a066b6040a7ca3c7439ee7f0eb07a9bf4f13cafd890d4a5e17974cde6cb672ddc64fb60109ab2cd31c56626807bff166b39759c6e85f713f9bf9975398cd56fee5342f67234f85f266b39a2fc96c6670c17dce017f5dca695e8e25acedef4a8ca0ebce2314315bf4fbf0b00a2ee2692121f466b3799631fa576ce8b7b8541384367fce8cdd31e89a32b2c430a1a7a91a220f89588d3e91cb203489ed686aef72349d9a8e48fad62807dce79ce7534ec9399b83e4d57c929a95b21df6233eeed5173775dd2dc3857809da5c94cc6642d2d2bc141a6875710fccaae121295b0f8cde375e4bb6eff7339bbde7c625b068a0a0c511f96bb512d35aa2b89c5a2fc13aa9ae32a13e7e3cde9879c3a137241f9f19d62f071951a0b63d43ef23e96d519cddb2da8452e33eb415d8603d635b671b7a7489d39a7603c7f48490976a9ad7176b5e6fa2633c7aa299e4bece95b7601a9caba6eead00359ca02d117496480abec51aa12387b58ad3370e9d1d5a1cb811f5154c6057fbbd08974bb980eb469139dcd67e6fd51a69c5b8d148d360c7756f98e4b0b0a7550bb6a1459d73dcc7b161cc5b40a1db00a1ed7ef31cdae36bf4b673dd1d9305b746dd600f58fda5fd879a2e90001bf584b06800617e29446d7607e25331b6fcb3423ccbba7cb30f6950630e77ae3b2721b0d910d30d1b4bc97388ebba5605a3e1908fef48b0ea1c0a1d54ce02de583b444c2da7dd8ee35b9cdabd13bb1236398cb44c580751ec61812c4badd35de9eadbd58d34889ec9729739d2acbde3adecf1a6b37dedbad7360cde1e3c3660e2969c879b30112e590116849a0ac8a8cdd30e5936e911b992da41508b771dae23479d1b91c3afcbdfc3ef9342e525ac932e544797a42724aa467a62ceeef7bb6bc70a5014f7af12a020c24494ca1c3eeeb57bae692bad5cd61b8d55976b5f1db9c459c662d11b4237b435bd329b31b3902b7c4ac107cc6f4d2e45bd11e9d591e72abdac1c5cf175f84d2e3e9814b37de90161ab79bbc2617b58286b179d77e871bbed1b1e3d1a8ef5c9df87438a7ff3f09f436ee3e399808d2cf496e19d5ccc3641a2c99f923309f7f358e9202c42a2f81ac2850c22cb4d1a9b343f8614596c75edff33c7cdbb9c422452e6fccfd5cee11e45c02c188cc4dbc4c65b33f5d6c1a64176627e1f588e0685368a9d1a2e260e7164e9b1d3b7297de7d8e9abbe73fc8fc7c32255056bd9770657bb81d38ffa8ef7c7e6daf1ab9a8aea50c93499439acc5f2efe42070f873ffef3ffd8c3d770f3173ac062873a486fb08322faa35f349d4a0d6439555682523636692441cd568bbe73679b3636dab3d502483036c5e8134215854afad601b2b63d654b7d6773e75833ef7601936a4ae878d3cede991c94f2da597726eb2a987fb926a3f0daa0c811a328ed3bc1551a475b2d9d3c378e90f3209a6fc95ad60fa01838fd5b0dd37ce439535ea54f01e535e1191336887b4839641b8540d8e5889badc645abcdf832872442560514b3474c7b2b6e43d3413dc95984370edca77a17493f80b9090cda5206393924ad33236bac5aa33dab3782fb2f72e7abbcd959e0c0fd2a914b13a7e2e502da1a165f7ae50f9bb90917984ce05657b1f8d2c2514d9981ed7e0d37102b5a063f6af51faeaf1d130bc316ac429d9a214110e9a233b326d233700aa5ad422d6eab45bfc8202c1ad8b0260cf1fb65e18e5005781a8cfece738303addc9722c5f1e61b480bac187b571c29d156a428d32c1ae99d2dc2462cb0e8522c2e47d31604c45ec05907af6b5bdaf7246f13120839045e60d511b00f861dd1293b5b2126e44fe0728ef8bf803f7f0b2d62b081f9c68f90f28db7cc312b580758dea9b454fcbeec23916a2113ceea9d2ee0deec85ffaff3776f8dcfc05e444f222a0ee73882ce1840f30d39e6a4fb4ad581d32796f2a0f38f1d4c845b552a02acf298021716bc8cf72209ae890ce7707e16affe3ff1d4adc727bd2fcc397cda3a640dfc4365593c292ea97dbab3e67da715c3540b5da8518e9cca97ce78d9e88663cd6532755d79194e197e82322eaf614ab169fd2e1dd35111ac345e1e51a91c0aeb7abfc313b9e2808a52c471309d65842d2bbd0849fa255e1092a25357b7f7ede893b243a539aedfb00e51e69d48ffcfb59c391893bebd7d0fad6124ed406f48c1aecaebcdced9fe6960deb1accfb1fa6e85b32d7f1effef7fd2ffa40f8ea11ef2f698d27ea0c41c8a6bdcd680c2ba738c36972465777d51ff35718e8c1f109e80a2d621dfea791535a031dbffcdfef39fb435db220a505987d396d64db95add54fe3fd25b86496aca8ed5989438b53929d0e58dba1a2b9434e49d3e0fafa2505de73904089be73248e6fbe7b9e824166524d6783018f370c9907bb9545321842e5a8f2d580d4639188bf4c30d1e85ea4897ae49b570e92c36d1fbc861743f0b3172a8b6728cfd0b7d194ff31c649022337e9f1267176f75d7812477bc34544c3e60f4fdfed5f143a4a1a17ad75963608acde3b428ff40f2c1881f3fcc73986fafc2f9a1be0603f9403e90fd519ee7b0905acef58b0271352f33c3d2408d971ac922656c4e6f5839e5708fb803d0c27140591db1f2e268f1ea79853f822872dd5ef5b3456241793adece57c289157da43a48b4131634194abada212e12ee4982a5f85e216432ab225d5a120eaab096796e2cbb7bb6f4fb5a6a9655e162d76991a3c806127acd164aedfc7e80595ca4e3f03d5bc54a66f182ef85a2dd44815ec6c99a9b46925a23c166f39b4c902ff4d6a45e387e777ec4feb3e8f399f93ba17f26c77c9cb86e52dc91a1c89954b72e89bd58e3a762e4ba09c53a55c622f1d5bbf3f738308158d31871c64557d65af13f29bb1c0e7efa8f377dc08f394422980497a3a93f1cb3c8f4f1228a03cd22db3e8b4e7ef47eacbab0cd903da7f202f3cbce4a44c6f2fa2cbe0a23f96e2393401ce3690b8ed6a10a33fb195f85a56d3ecf8b434c1133c4a58948840be880b39149ba91d661ae8a4d8428cd416ef2d2718acb79079cb3f89b03ce3a75c079e74c612386e3cdc98fe34dbfcfefb5585c6ea625515e8ae17879f268bcecf7792ae697cb296c85ee333d49bdf92a489e6836e4cd9b9b7eeaa55138976cc4fd94434f5da653d7dd1ed9007ef8536cf958bb6e8f809a7472dad3f19bf8b66803eae07ea9fbce872e8877a650b450877d2cffb403fc9d69a1c7a94a1c6897f33c5c32333e039e6b311c9b4175f85922a1a1d02ce4bf1bae690682d0d73ab8634318fd7dc0f4604d8a9cc25df9809520d37d85eca02a598fb5d07d05716e0f78c39cb2ae1e953ccf4bd39ef4e65af47adddccddb73d73d9cc7ea912b6e1f7971727dfc70381c1ea737d70e38f897db3ae7bffdf241ce3554d8532850793e0ebd26fe65bc56c6604ebaa841fe45ecb3920738c41012e22f175207f315f2979a3e0a16d381861d84f256815a444648318cb5e228540fc751c56746c66c2014fa329ae2d7de632e1425bed694357da7e882ead2a1d938fdb078c2a73732e67bf52c118ce56532654eac9c7e088d5aa674239be785d2cc445317f7c6fa375017f15b796782aa595d98377a58bf7c86d24cdcef8d208ae30dc57309d57c5586701942c31aa69650f738a367656abf3f04ea5afade3f1e43a77f88ffe8d123e8746928735e860b590cb437028aba4661f9d3f96bb92b43d0bc963b4a5e07a11de173b57881920a36e63d7a0c9b40b59342a30c412a6521b98343709e346e9c89d96bb8781d602ab34cef39949d78ff988cfc91f7e8510eebe0ee7c93c860416d601a90732aedd33c88243d77e22c43ede0d9b07e8301c4669bbf130509eee00a9ec273b88057f004cec1c6bb82ff3fc5ba12f777fe1076fe90c232563f16f51f1b29ee73584a3184357daeeab9334cbfa130923b7a7ee09acadc4ad11bc19d146db6dfce3e01ed6db65751383f937a152f520ebbf2a5801caebaa20dffbbb2eb3892a77a309ac881f6e5c970a2fbd297393ca5ae9fb73bb5559eca4b39cd3246ff8acb2907fa2aeeb572b8e8ea94ca98b069568a6abf8c42e4ce2098eae183b12ade4e1857fcfc701c5798272609379eda6708123cf739bc921df1493a084c0e4f9aa30da490907857d7865df56cac7a211f045e89207238977b0817427ecf7ab732cb428a782f92cacbd175597c2cc249585a3bfbc9be05340779793e15bb3e2969e188d4ce9b3ba77fdd778ee80430a71ff71deee4f0a5396c4de1dd5c97cd4e3b1a9ee0a8d867f24f2f8cf87b2372b3bd25eecdbfc5ba8d02266bc4399c4ba6252ca477877f763043c1e3acd9bfac74b5ae7b2e59edb7e5fb6571f6df5b5db177d791b6abd24a1c41be0dcfdad0a8cd5249b354435aa61cbeed9d9425eb051e6270d72d416fde672be9dd3d58ca81e4c7f809b1a8853c9183b9f6eef898a993a1ebc6a7c32c53a7746e0623d78d4f869c02fe63a17efc20f03a712ccf315f487826998405cfe15d7b705679a22fe574b0a60362cfd81c7ff4b7f4570dd403a68f6f780e2fda0d48ef4e685ac89dd0de0eb4470e42d20b1702bf790e6fda1b75d798a977c7a976236dc773f8204de4fef7b538b39af1fb0f2620edf75c38e9a1bcb62fd860b00ad29975ba85a00a288128ef42d63db338c7de0fb076765c39a7cbe81cde762feaeb06b06f24c8cad40857480af332dabf65d7231c785c64fd007780650ebfffe5bab7a7c309824983c0e5f0721f8b94cdc4a28e41f67a9f3085e877bf4471942ee5d484bea372ef1006630ef8e3547beb103f6c1bc54f2a8e1f271a877a29a724689812f49b6204e7f0b10bc13bce38f00c0fe2ba0fff411cc217b97b1a2fe4440b132fc1f103af644e5c973dda2bb649e48de33ffaa99e4ea28da3e41dc5e3a0ddf3e63a895ecb5d96492f88b4fd224a61bfd75207f4c9dab67b7fc5980f924b3d65744bf173ebfc209eccb25fb24cc92cfb9910c15e7bd0112e8ce7f0ba49943a3c99c29627137c2552fc073207bf3407f2559a0da27f8dfcf6adfac1e10f3918808d014ec93c87cf9d4dfc420192be5ab2fe87ecf7cd0f41712d7f939d919d5010fd2af9d73d3d97e4b6c51c3e7511c9dae359908a5792f1f1671a40cdea89d0b619347eb188ca0d527e2ae242d764469d30c5e1260e172c70dd80713e4e185303cd1f842c3a8e795fdb9991d0f98c6d799e23d5ff418a7b8ad8e55f4830c122fce7126e4279bb89134da13137d206b948fd00c2f4ac44f2963757d71d91f694e187cb73da516466d4a335c7b08e42f31cc2140586ebee7ebe623ef6d29dfd6b0e2de86a31e56b49b4e9835813ed200c6c3c7cea951c206d2eb156d8d9fb4075dc592c880c11732014cc84842f92c5dcbe60d8541af6e8d990e27d380a4cecd1434cb771f2458490943e0b960f338fff34437f142e4757d70e8777750d3bd0fbacef4587e8fc4cbc4720806fe2bd370f96702ede7ba5f607de88f746a286a41627e07b9d9b5226042a87a462783a2a251dc14acaf20e878548f618a6c40bb55cbf8ca3854c5271252eef65e4d78bd56dbf7114fed04474f407a31c0e161efd37851fee179ec2d5e5900cd30d83bc08d34d14ecc4d5e5c38e5447c54a3ad08481f3e20cef84d1bfe1763b7df6deab69e05cb77736711e2d98e33bccb187fc5a34ca4c1ce2fcb8e33bdce1e373e198403b5ddc47886cc73e174956ed82b848678fab2dacb80a3efa743419f9d54f7a00a5bd6db1d00fa4b71adb0059f4ebd6d84f286b9220e2e2b7e97c9f332ef275bce9c8ded9d17e912df7222d8b6b332d41d7980350f416486d16d54f88857aa0bdd558da11e32f3b62d91ab13423c6035f24e010f1e49b3121768595b837b6557e62bd06119f429c84529980f7c687ce3f64a2f896c3db43fcdf467a77245c5527aafe1c95ebd6fb64c61918f94453dc7f8dc869b7886f95ff519a68bbfecf3287f75e43dfebba35e4e0ba3df6bea9f2ceb2f70d05b5f95dd344f32c634177700a111c8a0dc2c144c4dbc97a0cbce492625438fd9dbc54d32923ad2f4bbc6d4837919ac298153e0f84c8e94eed23ad82c3811c5502e32e91654360c68162ceb36c7e32ccb2b9116d383d2f430f0494eee86f359b73f8b3c963cd1b1216f702d40749189471439d651095dcff2288a8da445a9451d81009874cdb1c9fede794d64550e421f835b92602446e1006dde37f705d86ab628fd19b60176fb5c3e18378d1aacaad69a24519f146aa2387889491f108a5a02cdf17b584be7344736a29e74c39db94012e39b3ea04ac10f7c5d9c466d35d96e3db5f2a36bfb1480b2e27ce9129334fd3599943a6670da44735d39bebb2bc3550c3ddad1f0fd888c1086656d633d0f788a0ee99644cf537fc0189c75797aac2ee7cfc26cbecb57983b2ad21e1f05cb622b63c57ad601bc4f9da2717185213980f4638b246f2c329ccfb23fedf919bab289e7f7120f0482deabad27c90e6bc25b05a34e0e0981b87bd6b66c543164943742d57a1530c7e2fcb5eededa435393d320d1fed21446a1f41b51675898c5f5a4b339ac2bcdac9a7f6b4dac31f2cb54c5e21c2e07083c2e8edc1f0247fc82cfb9a65bf66d9acf75d6933cbf6b0ea483ee2dd727ef19623cf6d5cf53a9e8fe81db9dec83c6c7741a1b64c5ca152a7f0df2cd65ea5e2dd9895ddbd5a91eeadffbe2a03f7b0051cb6f821f0f85a2b6307b5c4f436812386bb1e5e8bc3ef0d9d059dc8de1012cf4686229b39f3595f516ca8881dc5e185b611b98aae5ee83fc3ca3ad9e2fad76d56dec16d3bb0d76d6da9f60ffdc17d4838ac912cfd460fa658dd0d6c0275b1efbcab505a3f95d25b87cabb9b48517cfaf2043f833bef8e146fc50f0eba28be9be8a2f8ced745f11de90e8a1f9c9316534823aa681453c816a6bc946adc76ec0766585d9a207253d72d3f49d2bd8e1bd3a9858ebd4231950fe6e39914ba4d5a85e4f04d1a5d245bca81d0dcae94325a88bd139e8392770d992af1b07386a832874d226fba32079859b5457859cbb5df769f725dc23e8d503c4344c12342b62d77da629aedeccbe174aca5a8d9f1e842e8a95e8de0136d903799c399eb3b6de0637c2366dfc346661799ac6bd9bc2bf2a4e59e09f66a75d5b8f53661077676841fede44846bd09a2b0beca0d3b973bd11b96570fe6b19747f4d8cbd5a59c9284e6baacfcf694948bd4705e641501cd0d6c2ff8b027c4ace6b3435ad8e02a6533498e9b3ddc1275f290e356b6b8327335b4bf5f33c9419d8a47aecb367d31937d3693a7c3c9e091ff08f9cb47ed9b144557285486697155dcb7005258a161d3ef03f2029bfe43cb0be88a116812260df381eac7fd517fc4b94f8d6de20d36e56d95695673d80c06d8e0e6cf1bebab413c180d46c689554bd71d09d158a3c25ef2ad664ff938dc83145c5ed765af350b6123397cd62ce4702659c8794e576ef523561c0bc454620efbc09217fb49faa1ca1bc268365066b59c6da1856eb297388b0f3d619fc8abf85773cb031f84e2d0d3ae7b8de02dc49ec132e5ec5a39c6adc34ad463aaba5711a8de7ead928bb652de7529c7854b8682d641310b36d86247ae6df8352231dc6081cdf46bb70bf8fb41e0d98b772ab4c342bb12132e25af8175e19303a5bde7a6668a67debea1d8058f28760182eab6645b592cae2884410564a998f7b7831114974228fad0dbb929e2ea94736011c2937924f02ecba2faa1ceb25e54a09a09857b96813a8fc28564510b8063483920c06e495a2b4f6fd40d73f48266d4442023ee0fb085d8086fae9b9e0a7a7fb9dd4de4ba51fd060e213e2a203e22888f381fdf89de28ff33e4da8d575d9719da29bf8361bf48330ddc3deb5dc57330a160f7f48690f07b14646fc40c16bab896c283adbd3b3e30f78dbab89a32e93b4adfc10bc9e648c579113cf0ad641250008dc47d3e7e299973e7d0cb489203feda15bfac6bd70cb6a4c58039292fc66f70818cff6a23ee06aebe9eb099e119ee44544c3bf276dc6733c1e420e50f743fb54429f2ee065b4cd89a722cf276833926cce9895172b9fb2229c086e6f9584d3e210f4c8e6affa685726008235c207a879bac43bd3454d2235b4f58707fc146e4ec4e7703ffa2bf52e35f4d7f15fd8dc9a731a4ef84be03fa8eb41842aa6ba6075b4c99d75316f51f9bfa8fa5ee360a081a1b28bdbb016e22048dfd93de6e807b08c69cf56ba219d67b807ffa5814bf763c877523facc6faecbbeb1df38fc66e3a5c28a66323b309695ae82ee96970a8d700c3dd6d359a685280d4fb9ebf6983e143af840463bca6643237c3a18f1bf16f401026fdfeec61ca2fd78c87878569a4335c91c6e0e2c045d3f93e9fc27c08d30df9fe99658482f5c48a5c3652813d039ecf49e2ba2f2ee84f7f8016e679fb653793b9bb0eb9b9dbaee8a13341a945b8e486337387c4be9edf83192849d3167bea58dbda3bf5704b44ff5decb11e32b5ddaaa8ef995364c4671bbfb65c25224ecb1ee7e4e72288448e5e44a5f0ea742fa23fa893c9cbe1c4d91214f65bf9f734ea1e61a01a38d52063776228d19b2240d83fdacdbcf9ad66f342b7349494fa258abf8a8ecbb517c34853b8da360b7f56dbcadefe32d6da4e380e90e9bbfd2393c6f8343f55a5c04a958905581be9453d81a13064d78103f07a1f6ee904da9177bc09448ad9c457784a915af2ee57412782d33b2110716784d4bbb2c9b7dff369bdee3951367e5f4848865963977f825e9a295e120903bad0d829e8a6cf70db1280b0cc88482038b4fc490149ddcd2fad18445620bf393a1eb6e4f71c2a492c00fdc742b7262ef95c8198a14b7a236f14303a06e0765b16a00a77b034048d99efc1703e03ed673eec8fcae196910f9a208f1a46411d9f57c12c8fc529bd0dd64592f9c10e90afd4f594644be2f102b2b5ee3b9a2b19259f629cb3aed8b8839407011042f8ae770a1f7de90b6261ea479310684bb0dc57634af169c0e390f97ecbde6fb17eae3229463ef7596d59ae915cd500f334daf3453c0c9bd2b7942a7c6e8fe1956e5f0a58a8352f3236131b2c5b6e4ab0538141e47d3d3fe5ac405eae110eb4b3d45d2589ccd9d5f1ccc70e1d79ac873230c3cd5e67da2427c1eff60b4ae24f07f75dd11c27e96b1af2294e6c1e63d25d24711499148f1b3f8243e8b3fc42f2802c6567b5b6afe29eefb39794680e215b3045bdce72db225434cd51a2884177e2b0d5a73408011960b27b6e1feced7881f76f8cf2e9fc2efe2df741b0f6f259b11a8adebbe8df410e957d765bf896738dd279a21ade31c7abfba6e8ce8aea7a4ebf63e59d68fa6f0abf803674c43a3410e6ba37e21d9afb21ae9bff07b34e5b0d398fe2f091bcd5b1ce4e6100bb9a978c87f89a56d80dee97eb507b01d40f4df800c8aacc804146170105cc6aa86cb5585ca7314b8be167e200428581f31e1e72cfb95ff20e2f208209eee896293782c85b37228d77a8094938d2f8753e4c468319aa93b4cddf171999af05331a268035224a74344c5be73e3c00f2226b9f7896edd35fe505c35fe507b1397dcf1f0149b3dfb81f64ce2d6fc2aed48f0e70e7f9a21c0afae2b4f47e6fab22808ba28033d49f811ffd9b9ee016f359496a998118f1150d90f4842ff55a2c9b1819c1f087290a8fc52e89f6b0f6dd0992103b422045f0121a0c437cd341fabd3ef90b1fe77f28e478f11cd935ac85e338d2014ffa687d8c5efd2a807d44988cb1778755b73d7ed45d2756f4ebe4b42ef8d64331ab070a0f8310b8f47de433e7e82422d4db4f96049ca21c1c190e68dc5c2d40a393124b118e194c341fc8085c78f2cfe55a74862b0ac1a44fc98fdfd41c81be5a37efc20e4e3f8c484ad18d6ce68aa0dfae98b14f90c3ca074dce9f70e7f9354b7d1549208d23b23c5296e24a977468c538805d5e90c664219052e813ed28658169b1d2e5948768aa423ab04103c0916cef17b207e304744119dac97dbd5caed6cb91d96db710e5d808a7ca58155fbb5ab0dc6b9418a179382a8c349c075c9aa5d081178a5a5bbebfe19d3746f1610fba225329f045c5b715db3f2feb980f5f66b385b0e4f24db5a424fabb917b90531e1e0f7d3c7c3023d2525fbfd7092e8425fe8dfe7e398ec806232034ab4b1088f39fc2e649e33221ab468b85e1c3ee3a0ba740df09ce24582247f56fcb1a31ff04632a407384cc249e77b28bb752f8d49af8b70d7db4dc575145b7398c577dde6836daf39bc3e6492f05a0c730e7f1f0eb9718323afbd1abff171e37030ccc9a89b39f952290cff94ae28333ae245d20df988291851dccfc32e8713a669aae2fe47df46ff8687be9d2f3cf29d8d544e7e29eb71c0a760ea20076e2a37b20b46cc3c02f0bdfcdcaaea2c07948aa84632f637877471430e0f51342bb85ccb2bf5866392d85cd7f02c115118243b699621b95434dc2ceb6877a2bbb935cc2b1625f7a567ae1717179584d74cb91c4e4d68e1bbbdc28825aae6bbf38a0ecddae7565d46bf3e98f8e524e55b1ab41583115156336bf615115ae70523b161bf4eb662e80f9095a4871cb7c67272ab3987ada6b761d30926f98311c4c214443164f478382152e8f84e7a1b6ea48394393d79e8baec57ecd32e3b8b05157b5f03ed161535cf55710e76cfeae6b2e19211bbfb32cbd84bf1859ec17959857339c79ecf37522e4cc458aa8098ec24f0f6bca8785286a42fb91fa3ce838508e4f89364ce4d03dd0d61048f86c8595bbd1a79427bf1b6156ad368eebea7b518ccf903d99f23f664a3c1027f2c0c662a36b48d6a4715a1629f7039b8a1142961a9679ac5f0b26456f6163927b8b63b83a464820cf40161ec4cb397dc7f479c770e5ff65e5d23c42eeea320d52fa270fec51a88dee750263d0bd366026d0aa6a4517c8b0294f16269277c9037324925a5638d67722e2369ec9baa1a9d394fae52cc5c8426f24435a8ab60fee5890ad7cf64aa43659ce16ac99669c3a40e38aad4ce31bfaf28d768c2a4391abff71f0f418b445f96b98387d3cb78cafda2c8bf410ba5310994d75a35f2e4c7bf035dcfc4f5c3ac92a5e8aad8688e468b55f65b397d389c74d53f96fe10ba7a28dae2279e79e37abf17f2a76e6c1da67a3f3d6e27db1dc5dcd160bf0a16efdc4eaa50db9277373231d18e71d33a6d9295d7da5363914eaa854af533a98ad520e2524e2be58f4f559aca9aef55b2aa9a52db71a830b5d35c013915de3f0ead99ec5a337978cd9e5ca5386e6ff8b86bd7e4945e0fdb5f2364a410d921fb3497d64edbe9a3f46c7aeb9e0de82c6b6043ba656822438dc8905c400ae4464e11e5ae76eee5c19d3c082b12c1fe60de838e55ef1f5cf2f68257390f143dbbf42c5c2e8f47e6007c671b3acf6eb1130fbe335c8a94dac062667eff5523b5b182d5ad75b4cac99406d7f94d1c6f5aa6f65fcd2b8cef03456e22c50feb09a1bc5a4d1cb28a6f8d62a7ea5950eac00cfd6d7c0be597c9215ffa3d4830a4fb40d6ce3164b29edf851af61ae92eb473bebb8dde9d394cdf2bb2c322a53675d172905b9087dca2e522b7200986e64148e317cb01e1b9a3f7cdac33480e671d6aa54e760767d2290dc9aee34b777eed438689b5cbb626bc7977fc44d0621c2eb23345aa9725beb3b0e27bb93b3104f95736f84f0be1b2f486e3cff5050659822101acac8332e3393c3b740312918e0559a9488b39878aa19285fe04657bd207c056e82629f6ee4e46c3717afa68889c7596e9364df6ee4e1f0ef904f96a3f3d197ca7dcc9e0213991c662c4f3d875199bf745cc4f86133617c6346162fc4dfd21f9b470df9a699395b62d31f44d19530222d7b5beae59c666b22f6258ca8188416176199eb9d4ef2e6b7a51ebf15a006e966d4ec97db5be06168e4e8713b6a8dfcaef01dae970b23cfe5e010a6660db58878a2de0c7e1909729c11d5bc0c3c743cefd85c0a2911642cce9cea53732d671d669b4e1946574de9c886195e300ce0436b0e862fcbf3523ced6acef222d0623602acb22dd1362ce2bdf8296c96d7314e53b6f240ba88e5a14cd26876fbacb7f7474fcaf07f2c14d0eeff6197731036d5486caa80c67277a2285f667a7645424ec6b8a3104280115715ca5ebf63e5a2d22d660a5e4645c44b1403db09de4f746b00950b00978ce21295ef495308487dd32544ccde5e33bc91c2b0ba60edc37e213f8f7284b15b9fbf1debabcea9f0bd9d77021645fc12b21fb313c11e144f643df71f231fb22de37c32991f306cad3aecbec1781cd97c9771424923967e736cd0187ee88c039232b60e7e3c6011bebc6e17ea5d971c03186c48e311776b658705e14b49d4f5821e03be09888556505a916b51a704647bdf086ee6a9ec3525cf49d23a7ff8afe3e81b5780e5f5cb777e6baecac16ff691ddc91ea8126959e8e9a21a9ce5ab9b8c57bcf068b33585d3e9f8a0b0dabcb8ba97885ffbe9a8a730d4f5c97ad2e9f4c05269091b45df1755f3847d5151d2ccbdf3889a3422d032baf2c24b09322014b09ecad48d86e6c1f67e453d3bc5aa67795731be9e58586371a3e6878afe1ad867f6bf8bdfe8c0ec153a1281f775a4abfd788593ed0df325a89f11899b054b492da458c7d8a9f8ac06b3ff0e2bafb696c5e0b992fe2c901bf20ff801f11acebf8e197a6db85c321c6735e7853da50a3352316b287238790abeb83b9dc674f241b99579155b7b745b71780f578590c42655544f5d1f5593c71de6df573b5707cc7f888706e0cd998d986114588eb2db3ac976659e9cd937a77057f72a8516a911e1b15fb1167e085640b59ed5ca1d3b1ec9c6cc5fd8827437f04660dc805713939a0055e935bc492fb6bc6f97821b6b011bd1a3b9726f32c4b9acf923e4f9238c9b23ddf21209ffcf0d5fa9a5e2cb0df76682602d9cfc1fccb3298cbdfc234bc0a231cab53beea1723032052eff6587bb7e636252d2cd7526f377801c9e566e214c0e1f8ced5b5336d4ddd1b0e4746d90e9ff781cb757b0bd70dbb5e0d6e6ffe06a9cb24bc648bfda8a6e5039cd0ed29e570ffc07a7f07e8aaca39874743cee1853ea4bcb7aafaefc2515ca8e78b154dc4fd9d4fd7cb3bfc6797432066108940c2b6db7e5075ecc2076341a890ccea7e602d0853ef6e90787798541a59322a3e48bc9d492e848fcda40dafa381f69f48160df48388e7e3059917d627474ad06517f9dec29afb6c8b90de5cae352cfb0f87c5839aff8743658e4fc7607d93d3d95d8e18ece163ffa721cfe12599847d2493b09fb5b837eec843907880ced26bff6f278bf0e6880cf344f1f634e60dd6e9b5737a121cad12b914ceffdb26d1ff738e8ce19d70665751a0be38a7172b7944eff49e1c07a747f3781b2d8e54ac8faee411bd55bcf04e8e17e1cde9df60192773597b40f88d79fad7ef8dc03e6cec5f8e603485c68bae1d1efa6f0a6d639ec3eb7ddb3b1214d3644e6f1197a8a2b882ebd1ad624c76e437bf041b0a73811fe25ec71b7f0857b1d6f1da1fe6d6f0a5eb8172c939bcd43682cc4b6d42c80c4c3b9e8e37c5a769ab0a4e8c758e259d02ac752cc977b8503f8bf02499847e626272d52f34c74e9c84d7286c451325467e71e719115f2f6b6190145d2a2b31ea8abb22144abe444269dae653dc1b2b64bfb2555d0777f55fa1aa7ee5363261a16fef0cdd5c2c76d1c5382e4d9febba0086eb31d0fcf82187b2c4ae5d62375058a25f2d2fc4c6144be8536c6152ab605bf4ab0e6de19d505878d72a8c8dd75aaeeaedb05ea8aa4e86ad364355b5d9d940ce2448eff6012efaea8122c4afe84aadbd2f26ee50f3fc976b67dbe3e5d6e5a58ee156486f45fa82bd9dae41d508fe8f3bbd1f64aa3da86a4cf0b57d10c96a32a0380115b98e892813be78b2d948b530418c3e6ba62106ddb8b577dd5b8a181b50410a0ac1620ec1e167cd5cd7742617f845b1a0571404827aadfdee0eb5d028620da68d0f3ccfe10fdd0a06655f2ea7ab78db6bf1961d0526585fd763f8d6de3b067a198037df19aab537aab557b45d08c693e64f26b96fbaa2eb42edc50ab3057e100eb7e9c677b08ce25414535015dc1b0bed188e40996749d3642eccb3053a875fda6aa20ae9d66ad30570e15ac1eb31a46ab11faa57bf1da3d5eac809bc825a9531be2d3d02ea955e1283cf876901bfd759c67423e84414d8e8d98551919a48efd66f84f3ba7d5047ae211559358bacea45c6b209763d590224931d1068c246942122f60b58f7a2d0b8e683eeaaa7bbcae639fcd6363cfb589a6056d188408be1589f945963ddef7326c5477da9a7dcb38331ef491adc45ee345f3523bca3e59ab4a9a97c16de00c122900213c5c3f7d574f8983890dca838ec73d9914cf6951c51f06d87acc19ed39ff508b527ec2d59fa2130f5aa436f3f43759d6577dc062fb8965a87eafa59a003631e6080d975ffa0a78d4cac9d6a448dab802a54e6cf9a2c364d689954bcd142c15b2d12144d71a84f34b07f6b23be56dc0b67fce4113d6e1fc5f1865429cff7dc0ed99efb2b9081b4618a20a6d32bc8a65b9e8a218495f68f22d850080a88eaa9239b4a0e8b5a8cc6fa44b07812f921a70d46e8af9699cdfbba5d34f4a3eea2034dcab9e77b61215a810ada160056ceffcbf27dd1c9010d21fca60f054c28720ad7eec6c0da80ffa60df86f0ce0d75dc26a58c39005681df3e6d92ecb84248456089aea995d2d6a15bfcafc1af264251d6860e4119d2513c9cfbc1760e0aff3390af27a2b6f67df68ba8aa57f7230eaa08a217fb5ee8a8715780718f72ceb9da1005e8be9605eaf35d8e974f47038a4585ad6dfae75655d7811328de7ba30da51f513c52cbae163a34369ed82d5d7a68d2a9a8c4153e3dcdf38fa1a52c314232ad3167989d4da0aa58d28546dba5d73be26d54a6a3048eaadf41ad937f351c4f59d6c1b4c8bc9e4feb646d16c1afca259cae1b56629f91c9a86b32cad334da905036e7beda50d2861f1f7190dde161d2126624ead21eb9542cc81107a0a5b88b9350ac2656c701b8d4787c2258b0acf7759d207c309fca219bd0752be3fd16c46551ccb6b2c89133f936538baaab579f302a07232e663d5642227bdf7da7555eb54aa3fe7f9d4fec1f51b512be8ceebbde6938fd62af31ec1c65760699ebf85707dedd39c6ca82f0da6395f429b0cfabd61ce7d5a6b055b50965ef6863c6f2f124595a9568a342d38e537b5520e7139795e0f6e5e8eddd09285a820e2a8fc1a0c6ad376605387a0455fa4f44af7c4717ce7409dc1c0c8ff50144550f31d878f4d82ebb28d85b235316804671b0ecda3b1e19036766093a71509ff830e47e275202abacdeb7dd08d6853ad3d4a5b7b9476ee51b11f08fb69b51f3cffa0b38c6eaf266792a5dc675a8aad0d55f63b9d1a3c9ae1fa9a235a915183933529f5a96e8b40ebeda23954aed04d86a74e400a9ebdf851b1f71c5a7402a5084374645bd41a950aff4f1a7ed0f02b2987feb5cf381b8eb81da29f9e83746a6f433a7c1c1381afbf17490077116c1ce8e152c27d9c84c609d39760f4477ee9c19918c34dba4bf135d4ac607d9565a5a92714155a6f55c6f6d28cbaebba2fbb081ac61dcfbb3c7d122f5617c1867e19d6a169536af3edefef301766393fd5a873d5706b6b6b3e8c8dab944f9a170d14d56dbf5d0f2a416cdf48ed7d76ddde1ff8bf2c9e33215bea4ffa3b1d801209c4e2575d3785bb1bc4de1d3f7958377750de6e107b3b4c6d3acf51049d2280bd0309afec921d7227a9998c9b1affd2046285813395fff9e3c5c5bbb745687cdae5ce37d9b3acfe68530141850b71a8ae2339d048a33b7ae4e31792fdaa21a1d91fd020d8a2d50ec2a3e190e7786800a1ec994cbfe87863b4c15dd0562b5087ba3759c6ce26cf1ba19af7e37a6d37b5ea6431e677a49b40c2ede446488d1fe82179c34e39b72b29237377683e9fbd3b3bc35ff68a7ffcbc19350b9a3705f6852f05897d8282eafe8e2d952c7a231853a3fe0fba16d3e93bad24868bc499c8c52b552852e2e6831aad52144dbbf312e51b95305728fb4d73989d8c265d9720c4e611b5910b87fbdf69bd2a0511e3ad9732e3c3235bd8b8b48e795297e7b4fcc57bba8159cf7ae89422adee80d9029df6a2c4c605727f7a55e7e5d6557e161071d0591654719daa3d6a07df9935fca94aed47293114310a7ba5cf8e01b62ca3b8695d71a4cf1bd6550b19e9e0b3892eb2a5578c0bb30b4e464d46afd30e140d3f68728074a8fa27275447928f08ad50ca59bc9013468546ff7c60133f61ad5d2de133f74d99668132b774273447eb19a69aaea457a57c725dd3cac01bfdfd412387170d56699f277bc53efb7b49255fde63f4064d18997e0b5d7c394eccca0b2f53ab305948ef6e8023024596fef8bd1b33bb5959a64fcaa061aeab4f6bfeceaaccd9b9ae3aad8282753ae4241e8528a3a01139e8f8fa3a92871095163acbeeeffc8df4ee8e1ff6d7e65ad00435c05fbb028b9186a0f1520a334051468a8fc54c08a1c67bc73dae0c70e2c9777cc27c051a1e3d7ac421bcec7a4472efbab586644aae4a2ad00a94825841a22050102948156c15cc152c146c142c95b85f85a98e137a72e63a882299ec3ebe7ae68f7258ab8ea8100b85646fe5a5dbab5427a1ba66233ca44d05b26ad96448c541b51315eec94ced5b47adf1f810d9286f170c8772f298ae8aec6b48c7a483a4774bab77ce6c028c867c302ab28dd7b72d3ae41c740e3755c7e4ec1c2e59d70881900457aa4ea62529e2cd0988d5a451472beec74af48616d4e7fd112813eb6bacda51d6cd2bd50ec54d28dec32d34c091ea9bec63a7afc7a9ca320a2254ac7fe1ec169329a12afd51432a91c865b14cffe3f0cbe1b4effc8fd38fc71b35a18f5e197d2a8ae784b1a855d7b5c070992a843a9286ceb5b12d4069c67c4f99e340c98feb50471242eea76ab250a5523ce4be1dab882155648aa30e313bb86223f2bee3562dfbd20ca49bb5b1997b2f19961ad225c9fc1078763efc7ea1da33065cb5116ce96f4a7f2365806fa3446dbaa13ab2cdec53c44227f0f1c39b3fe33ff6822be2da944130bd58bd0cd295691749e2b86549c7ef035ac4adca323657133b24b21e66dc8f68f169b923e56faafc721e1d9bb650de26d02b7a067da1bc94fc15ab7d731c0e2bc52c637078665bd7b52e5307a591406559ad4c3db471a3dc5ccc14e3e624e4a50377a46a417acc99e0634d1e94ce31f2ea2c5258a8424a43d09cdbb7f74c081f5b82528630181d7e8c382a83c7fea59d821f1168a19eda024c8429f1ffe3ece97fdbc695fc576a63a147d663c7d9e2805bc9ac9097a6871cba6dafcddb0556d01364998e7591499d443bf646fedf0f1c9292fc91eeddfbc5d6c768f8351cce7086338c15ce50fd668323891a4177fa4dea81ad84913030e063d715142a94165de4ba7f7cfbd42f430f1254fafb5a84fc68966d05fcfb744afdad30c76d29f4b24efdc4e9e1404369332d835e7638f5a5c940cb77a5ac54cd72cde065fde92cd2e751dee4969bca93ecb026cf2a8f44cc5424dae438fcd07e91f7a3b477f1950234a25ad115f72d38ed3eaa4e323e4305056b534e41add70f4c4c60ca1f3ef1fd90b12a944c4555ec0f2bbec4fbdcdcd75115e39596e75a344e357e47352ac1221143c5de05d5ec140473340bb3e55344556ce2006c8a62808102eb36ac261314ce1352bfe19e67a0b5cc84f28b5e256ada36e1e479e7498779a109d6ff18066be1e460c2a14633b8d1e6db6e4cfb2eba46597fc193ad1c4a8dc4578009eda1e24b5f42923c99c4d749e2fee7fe149284bbdb856faa06499299679914b5aa36999255f76eebe7dd010bb429e518602f49b6aca260fae2c3642bf00cbdbd201585aaab7bd18a252f99c9c361a290761075dffed00ec1a197a4c4e40f58e5f5049bcb38e04d2685e23bc554079bb5d665535fd51db048923023fa0ff4cf24499e5a7ec5e9e89a62b5da809d2250338e403d6b4f4b2de84493244f918aa9a309314912deed602789730abf4448e6947446b829b66bc0a267d3eba893702c8f76f497249999befad2bccdd0acea2cf8d37fb1012fe708b12df38aa74fce9504677957e952579a0cf437c6709d249847d6f3beb804799e37584e92a41a8d9ae6d380b10f93053787e8be61aefb5c3cea05807cbaf8a6693e52b2eccd8865df32172066f6c5b59472f66552cb4a1de5d7ef47314992ed2449e663e5ae30e93f8b62e0935aae39b96462350975a0803a702d2d184919518c538d48ff7040dfe524f98a21e9344a9233495e0e9052846239546c476a4821070d29bad390f5443e0b5e7dffed3f6c6c3a139a5a80a1e622cc484afd82c29c08ade857035678de82a41435f4ae7fd65de8472860633235dac4b0b06a9335cee19649cf939a149ae60fb867b7ae1375ef968c7df63c523243209b70134d63ff3ecc8884a9a15c0a9a3c9e743bd76c1aacbb6c81eb237adb23193d45ebd85aa7c99ea9681dd3a619cea52c78da9b1efb50c3f8432327f49e37cd506cd6735ef561532c03f6b6aff09afa26e7a1cdbc4ff6344c490d2f8ebbf8fb430fdc98714c4df77a109ba6bbc9c294ec71bec2def01fd84f9eb82d6e8f03efef4d36727dc7043e9c6383e75ab330d678b265b7a6c15bcf430c8c6df59fbec3980efa1ef73f351c334461360ff460acd83458cdee83d568645235697c2b3d7d7f8cec4543396c66166fcd6ee523db110e7bd8b26dd37c3ea214202bb69f547c493d6fabff076ce57964de34648e8928f1a1e7cdcdfcc63bd7f5601faeb01fb2a679843d75abc5636839f3b3e79167f648a16489a905dcc2061ea1a4b06c9aa1c90735d46c0979e5052e2a5c0c0b810ca7a43e9f6c31add87048fd52d75cb332567a5ed91359068c235167646bd226238363cfb6861bcfebca1a1c97852bfd9a6d5a1a1f8f036a3f8bd6b1e7e544ff1bdf8835bbef03dc22c003d1ff8094a0f9fbdca2d45367de9f3a7764ae01e7d168d4fef526f8ea38fc24534d13c596d4f8a539c59b861ccf094ec34bdcce603e60a811cbbcb532de969cbc92600c36ba3d2d2bd39c6d410bf38f7c6e7144d298c29889a6a9062c35f38db1aa374c94fbed329e364d7a34828c537e642dab281426a2396afcba536b9662bc6252b37a22f84e7dcfe78559673633e9fa7a33623f530cc3c82a8ad3e30d0ff8241735afd4dfd1ef86e8666ac1f5701607302cfcaa8fbbeba66d2b808fd1b2114de3109303b41b0bfaadcf23e538a240fba9e56f1de135cdef26a5baa2a1f04d00ebae98fd99680829c664f2bc619b6440538149ed67c3a9823d748216f87c49ce38ada0d6819c897613f10c4876c1b59944455422352b2d0a4b2a3c0faf44d36c1d1c28181a238ee84005959e277447302623151fc3eb17c6143a94ae273d6f28ccf5751c928aa901639894dd6d6c5cdda6a5da54fca72b5d1e05929e666ca736bdbb622905ccdf8d5ae7cf681d45d5aa602f68472c708840841273202e8c7afd0933d9f18a287884cae435d44ad0a597d41f1679ad86031c87a1b555b95b4c89ef5eedcbf6396ef1dbeb857c4673aebb5f69cdc85c0f72dbcf3c3c2526e15fe2641a9d56802bb9a98bfd77aeee9d71d96224e7ddb92b72f1e487a637435b44d30cae19431fc793434d9793b25ffff2cb2f06d3104e47c33f4e9cf1ff46a0e7d249b53c6f70f5cfb4ba6a67cff9c92c755a2e4eca1e9b7dc48cbf5ad928da2c141f4c8a8ad0fea34f6def93e7139d17d70213ffff481e97a31125b915c3a55ec749aec5070efa4f93e685252fb74b5edec3f4fedaf39e498e85826209d1926a8e5ace9359931121a8cb3aad5b448dd4ae68bff93bcb5d9ce49bc1c2b4ab84652febf70ee698f1fbc61a704f16829e6e497bda64404af641cb48d4f34aa268a0aafd0b32fd0bd5bc419967c79415c1e68c94ecc6a9800f7a6df6bc3c2ab5c411c31d2bc3793837b04626f0f52b3f4771350b1f1959e2d294a1989669c99c6935e2ce27c33651f630176f6e3cefa6973abb429d24349f2e312bd80dd9c11df549ffd9069fc1b2df7676034bfb3dbba730d7d253bd9993a586338aed0e96935a61f07b62af90092d5b5df70e742d05cb6185f5459f6f7db142b1cc4c017d5f7b1ec17f66113909ec064d23bccab77c817b8e1f2bb9c6bd88f60bf749fb00f517bca414a47908af23223b074c4177ab19b2ad430b2b6aeaf9a3aa98caea86af4b2930194251fc2a374279dea5a7c4b5aff7f243be68bf48929511672ebcef1cd8feba5e3b93e8e262f5bef18ce75b6e217ff0d250076ac4bcc355afe4a6405f2dfcc86cbd7a1e72b357deba9e863bda34a8cd6ace873ac9cb195119ba40596c3bb06076881768e65e2202851056efc7eb27a3bc193ab35cc7f30adb9f149e89820c380dacfc74b8d83bae3d171ff71a72711cbb8fdb813cf2d2bd004c903d19bdf868fe5cee16cb8d2acb8dc0754bdb2bfaff2be35032378d89236d47d86d39f404b96b92cb567a6bc030dcb42438bd72fdcdc97b345faf9c748cafbf8bb4ac57d28aa62e5506d9bffe56f703a5f060b578d4868c8a68a71963a5561dc3d2f2cb56492e614d381c6b0d0f347cf0a387f86c5d80a5d92e42e27995541e2dc1dd61c7587f349c076c706d1cdeacc8ef7947f41c923e25f62854eb29fae6965882edd56b41cd22b3c8974bbeb0237bc85295ad7061c7027089d443cfedca7d68cfbd24496f077c6e542e0d99791efe11059c9eef1929fa826b19f6c50a2bbb7a6d7389db7d7da4d3b6666d75702a1f8ef6766e8f15b0f6983d6cf438e0017761e972e5d64b5409d8b0de3e766e564d935bbaaca8d197a6413dab9c7c521feddf6c5815d528a4581e699184ef18639b89900b8e3e781b34dd1598daacf5e76a1aced886d217ce36a0f1184dcd6c46b45c97f7f66d4f3cc14efc0a1ff80ef540b2a201677978ea7668f6ce2ecb903f4fa7d32bdd0f36e426f52f7f6d0371c26a92d79ef792d7bebe3a50b067a40a4daf67f55d0e185b791e9f2c52959a6b626ed8ea789519b0caf348c5fe308a87a102bbfdaddba657da4c4b29762c9be6339dbc22b7c382ad5e7d372868af4c1ce9257b3940c9a64139e393d4c9bead95a41c8de832eabf89ca7822d2358fd9e95394ad02b2689a0c13d7785ee6790b4df06a5d3096d92b54a83a27d64507d3345aa538904bd148509fed0c44820e1d6bd2545c358d3518e94b63426a1aad1057765b2faa6290b46f61ca2f6eeea8a88a9be607c887d84c7793ad78f6c417f6569782aaa8c6614ad7d7aef403251c56b0841c0a0a8b50d9ed521ff7898f19ee05765bd3b0f6a33ab69da295459e3f8a2ff3ffe69951289d4680bce033149482ee6e5be55cbc59f50e74909aadcc9851cfab31a63fde799eaeb9fd06f482869798e7a76def255cf65d8bcdde5b7cee4bc4686f34ce96c5f6d8eb9dd39b34ebbca4a884565fb36624a6ced9a5e85b251e8e1531bb31f261b2116b2314b6978453209271bbe149a42bc3f3da4b6c1d6e0ddf1169e84b510aa2695e55aa9a8608bbe892ca7c8daedfcebee236c42c17c61a685d04e7ab3c91924c4da9ee9ccbafc8a5e5831ea45995250a2fb8edab911bb5139941caa641daed87a5a31195518a5b95fa1fbb306839564eaade5274efbad8ad98d62ce7541e33242df8cda939380d744dcd626a3c6973dd0d8231f6cd6c33f8c2f384314fe0cc01ce2a62e429c00080a95e5877440191a1f245d3288a338c43de349fe133f414d133e38ae70d641889d8cf4d61aac77f6d8784470cba0f60361c20d534f01924853949a1bf13f0dd080ca6d5df7a2f9efafd804671a87b66f18dd1b7b8e10e867f154716f2022de2456b212fc2cadc6fa222c6abbfb49017ec5d509c5bc88bce425e4745679beeace49b232b794a0cadc346f73747c348a52f9c41a0d7ec5f7b8ee8ec2549325fb1619264b7c3d1d7d10892c4e7702b45bd59f74f551e5bee5cd95a6082af95dce68b636001f2981c4f446a9c9451ace7fbcb81462a661a0a2e815ef0ac9307037951193b3af0dd99ab0d2bedf35a61e4c0923a6c9b393b8ee96937dedbd3e197267c70f971bfd6a20d5bdeb375c33505f4da302287962ea16777ef2cfe13d7bf28a94fdcd0f4375d98388809265a1393055130348ad189c7b1c1778331da0cd86abfa8cefc8a2cdcf71edc91487611baea63fd4b888f55faf82aaafaace06f7c7911b2e841e6f56f69912f7e54c73f7be02dcd5c84dcf4ab5048f1c3a63f9dd5d712ee45e85f7bd04a229da388711178d5034e92cd4598871e8cb1d6d517e13e381fb10ff0277c818ff009bec157f8ac85d03ff454fc9d5da559ae1abe23a15f378f8d68cae627da54e5aa79acf245239feb662db246a8e726173cca5671f3a794cd3f65b56872c5abf42a0f3e6896727ae8a575e700192854c702add4106136ffa8e70df4ea82cb2a5a027b8b57e717d17b786967c81dac443f7a31a9b932be7cffb70f09a7ad75541c6d63dceae5bcc37bf60a2d73ad177d89faef1d134e0e505adf5207b5aae4f31b7e38c09f9762205a871227d7a1f37bafda07d8f4763f5dd32ec5fc0c9cac832c0d3720dd955e7c91cfe96f43f7d4771766b9eb205ef3bd229c71b357222874fc55cb549e278980768f93b7656f3d8fa8f6ceee1c290a25d18f283d6e1f9e5c7e8d995b64e612c362f253c4fc35c476dbb7364e261fd985067eade43aaf7968ff7b1fab1517c6d7d1bdab782d8b2dda4d3a3742306e3053f8c63ec357363dc07ab1ffedc70e818679bb781664b892f5a7a196c2e464051593b6e2fd63353d672d54748d335bd85ef91ca3c95d68614a0419fed7f2f77c486d3ae1a23b31f8c895e5757fdfdf2f301f639aa9a492520d69d3b47073b9d84fd035d58223270ba670e6a2de2f0e4ca2ceaeb8ffd9f06aff9d171cc5d4bf45b821364c92afdfee6e6e1f92db4ff7c9879b879b2419c67fa30186a161fff9fdcbe7097ab19305cfe482ffe3db3d919d5a4be9a4acacd7d28754a55a163549500335d95478ee9be1150d0a56919c70787125f92f47dffa4a2fcb47ad86821e083d1c40fddbf4f9e474a07303bd78e4af735c820d644cb98cc04d338585b1cf9883ec986f18cfab2fa8611b823fbf315c6a786fb277bf69a1dfe0b1fda1696289788acd5adc6a21a469deb998b73630d3624ccaf1357d9bd1ab92c25a4f83158be2c048a4d340ccca408c46d4cea429756f402b2b563a15338940d6bebf844dbf8cfa8af048c4269cc0155e9a102718ed62700d1506c328836a96a32b28c1adf5a269565115cf303562c1f435a4ac32ed4ad84a2b415b86f9c3c3cc9f06c9886d413f1db1cd680b6b7b8478e7a76f97a3f46d067b3f01ac835f83a980bf393895f7c5bc59b8372e0cef242dcb624ff42dac2894368252edaf0f87033cdf7cbcfeeb213f72b4b503ae3b3ac390efbd311727630e2513937cc1d3c24e2a935bba691657effe357a581a8f303bc03b3685aa1bc6ddac0a76a3115d5a4583f168d70ddbae1d36d7b7bd31be9e4edfd6569e58b1690ffbf214fb6ac496d12e7e5bda81ec61595d2dd0092199d9a4715bd8b369507455d40f1f191e1c1b9ad85543dc894e8bfc517472c53323055235ecd834d8cd0a2cf979d42fac7cabab41c7d95b84bd2a8247f37a594859e999f14caf7ea607db9416cbf68748f4f28274b7f7a7b0f31f477b4b735b4759e581c25ed3ea6ec05885998cf62396d1e08e9587368dcb9c29b28684c22d9bc2dd518fce4f7b143f7860b7706fe1244ea8b91e3267d59ee568d9bed79dff309231dc8e4608bb6753488f22bb5fddeb79d9fb1a8c99bc400cdb53f65158f671ffd660a6bd2eb8839d7fd601a9eb003960ace877c088a5bd5eb9d30f0fafcccfbbde54dc1c0e2e5bdd5ff359b3150f6b408e9ab6a4056ac6a656688b6293bb30eddc94d769796c20b0903c3ed8f03cf5799219619965140367d3603a6369c867a9cfdfa7e66634e2fe78cc9d6aff23041b6651a890cf94cfdfab60c3f0f604c9b4db1c38107a74737cbcd51601d2d28ca92508968eaf754122e433e1f3f7c2dcb862e46b75ed23c2da8260ea1415d6f90c595767d9afb3be01c932d777d92cf5b3f7692099edbdcc1f8f335a4732c65cc6918c4744867524c7d7fa896f97ab9c2d5cdf2d66ca5fbc5741eefa6ee18fc70b5a47d338ca358a696c5b51b2ebe05a1753ce52bfc452af4da9a53f1e97d4205e1a28152e67ca5f22e26b8378e98fc74bc3922ad3256b530919ae67d25fbf97a63364381aadfdf1784d2bd319511b02be8e3671948faf63b04d1ce393a99e63b15d0861cb06d7d6b1710a2bd61a8ad633e3b18889b3d64d53456bfde92cd1eb69c2ec2d6cd99a06883e8f19a958156d634ca556985ed4c5b32aba8e9111a663760d6acc7e064d9481c2b8449a404fc8c1d8fa6a3b31a21824132c6745946a9c2a1ee9553f1d5d07f98c55a19855be785f0592e1ed6824fcf158d0da4aef91ec36bcea03a1b15e26b354114121ed70ea7a5d6326b49406ee83d38aa119d21205564b19921f593a5533e1abf7c29098a653e58fc78ae617aa921f57e57080fdefbbc733c1be150926cb7cc717499956ca44611464a805080c97770c6344b65a4368a972480f071afc6f000000ffff05581872fd090100",
+		"ecdcde05099f2b2012a1f4af80e03a32": "1f8b08000000000002ffbcbd0d77db36122efc576cde5c2f1941b21437bb5bca8c8eeb244db6f96a9cb44dbd5e1d5a822c3634a992946dd5d27fbff30c0012a428b7bbe73def69639120be31982fcc0cf667cb64524469e24aefde3cef156eeadd473337394f2fbc4c16cb2cd9c3734fde2dd2acc8873761b61705480aee233f15b1bf3f10faa37fbfd90c7521894293308eddc8941591a89e0b8f5ee260bf5fa56d507712dc6f8645ef3a90a2e84d8284fe4e83aaab2211d4c1a297e2d15bafdf5ffe26279445cea2447ec8d285cc8a1567bb97c9f25a66e1652c7d6ae54a167ebaf136545f16d8437796892a3d75f68362b590e96cef6c757d99c60707eab757a467451625579fc2ab83835d2d6ee715f73761bc94bef3369d2e63e96c3cb1abb0331ecb5c6733c5f6fbaabb456df8bc3a8383e4e0c095414103f0c43f0f12b35472485fbfc15727e5a69cc08c491e1ce0ff5ed5525508139f06ba73934c86857493651c7ba88e268c806247d753e1504ab88c0ba739e36a149246fd843b94f3bc54932cbd599ab90c4f7b11f5c2a3caa9be4894c32dbcfb129a8a8b4def324aa6dc2f11799e01b4147394d49654c15163b4a33247556b4ff77de3b77c2c4119fd4a84133a34fb224173a9bd24552ff5142db2b44831c8de3cccdfdf2666b2d4764001d4b1089c43471434bd79e05c4f573f39dec6bd77fef9e9db43c7b747b36fcf871a9add3a40c259e6720ff34b0bae165344583a6b5448a5bcc17d1cfeb13a8dc33cf71d3cc669387504feca692359ea0fb46a8d2f5847b1c8249ead4f3ac51132cbd2ccfac0ef8e0897459a477fc8dcfa54a63922cf26274591f9ce342cc22ebd71522e8b7a2a25d00714b1d35515d75172464ffe377d3159e6457afd564ea390f0928892a8005412ae79194e8a34f307bda7624ecf7eef9f3c4c0213e93fe1c757d1742a1364a729fd145dcb7459f87d51cc69658b583e9771b8f2074f9e6e8600e102e01b05b287f19cf1f8d264165dadd72a29b793a82b89a74aacd76e44704dc8b4b8a0b5f7c47eb15eef173d42552f62792d9322ff4e2dd5bbf0daecd67b1e88b5b01b31995df99148d2b3e5027814888381200b0872d3c91235e90a4548dd7cf5e9ed9b0f042acb4c9ae49892c3e9f4c50dbdbc89f242263253db4d7a22a78f34e97a1ec4925e33f9fb52e6c549125d87e8c7cb8c7ab85ee762527d7c3d8de52941fc6538f92aa6c1e17f16aacd4787915804e78e021547c3060343944ce225c39d33b6a0f042cc882a88ebe024cbc295b5bf68f65f8493b998b7efc7194d2c4df28c2759deee7d94572fee16aee3fefbdff9fa3f9ed3293aeaf991e7101e45be5e413d7725d600d0155d2e09153a132c8243e4c671bc8303e4db8871a3cd39ff60cdf396b2e2c13a7b947aed7a1d678ffa44d8e1a65137effaa19b04aa110f98ed7f6825938b389c4820b33d1af046acca76f6a45b28daaa087c3a729af0e0f84e26afd31b594f1da6d41b14f6c4c22c88ebda08ac388f2e98746dd0e6559d9a472213a16a350e0cf1e1165c877f9c12d3f386214000e7102579112613191007d2c396504512b19f89fdd0a3c4a92cc2280e2241583eca17613199ab3c317ddd88dbaa1b8c42196b0ef743a2ab29c1b086d55914c7eb75d45bccbc914b442ce911023a38509bd49a668d9668618ac6c21884c5253d91ba049c12a49186c9f848ef741f40e5797ed5082843461c1017dc88bb561877a91fa7e9f582da9a9e15ab58625241b981693c06d4cb2d7201a495040960359dcda87b3f47d3623e4c8ea39e46a0340e1a2591cf127fa92c5e426b64951105bd2fc28c46f08ed0a759292292a7813b93c1f985b8e6bf7319cca418cba041926430978c4439432f96c955311f5d4b9f724f25d8c305fd1d0ccda7a147903f8f6685ebb9de103906b45d6483459ad200f69311e1b4c5225eb9c53cca45985d3126cc3d9f1aeb2d96f91cc86d4168cb5d704b34e17346fca3dc5f7aee98782bec45cc42fe32a6fcc158d2ab275eb4a39bc266314ea9f21a5bc10438e0ae2441d999e1a96b53e9b2c77ab76cc4a72dcea61044dded792c02e689a44bbdcdec1d6d1a4d7a497aeb7add74581c7ffbed28a75e7ffb6db7a0899810707b6ee495bc8e556d6aca09224b6e11e46e46c5b853af03f77742e8d1f51590f96f789c8102e0edfbc049937c92a571ec80ada3953874af62b926a6c1bb4c8b43856293f026ba0a890ef78877c94eae68323cf125e88b9fe8df2f4177201ed546fe53b72bdc7d5aac9f8efb442565afa0399405ed38974ad0c87fac653780481313043f50a61f02472d2e71c477b4d297e974259c9b288f2ea3382a5620003f50c5ae9d4d5ab05dcf4cfcac9daf51d346fcab01919a2923a9260c7ea40e32ccffdc25f9e6bb0efd7985a7cff43404028a82486fb20fdc3cb51611dbcc7de6c76ce8b961e0deb99170d24538e156d7eb81f7ac8f9e715f4892d80f548e1b99cde2f416bd26e41601777d9792d8c38c5d440d7c24b695d6390c3e3f4b69a3cd68f3bf3a4e7b597435a7c7ef28b14817ddc1c1c1cf944a6b488c556750e2e57023e4f6c626dc1e8a58e4622926622a166226aec59cc866da33380f2caafb913a64d82feae04fc7ff64d166ac37bc67d0154145a743384a0e934ec7a392e3f3e482800b3f0a4f7d24da860ffbdfafd7297109927813c2e19f931b199130b795c435781ee14c7e18ca98b86874691a70a57514cf6c26718f6132754081dd6530783ca559a7872fc43cd043da1b9340f1823075b0cf522de505f5504fc78351d69bf07cbf9298d9674ffbfd830393c6f8144923fae71ffda3ef9b82c4de443d70aae207b5d3bf1cbbd7c1ec317f57cc2c4f1cadcf2fcf9e1c1c7cc49ffd1299b95f826bda517dcfff127c7cc69906c8fef7d1ccef1302dc0f82250de75d102544cfb91b9de5e3b9f85525a8be76681569533e5e12ebb604a498196a0524f7bb20d470e23d0b40cb7ea6048221ef38f895de3ed31b0317be3e9e53ca2b4a01e0518677f4fadd7afd79bd7eb55eff8c995600a298f2f5fa47bd6ef4e125c67144839dd2cbc7e3a3f5fa97e36f6849fec559c4921038c465b3c2c4c9129affe9d9b7de25b1195f3758f0fdfce080aad98f51d5373439f8c333e8be3fef5f30f15762cdc9ac90d91b7af0ca6fdc6ed557e2b220ced0bedb869fa8578a2c1ef73d0e541d0af6a879f4847a1a03c116b20dedd31ec808146a62082d45d4aba414e2e46b94617f202a3cce146289f5089f7df3ad4dad266e2cee0b2de884c46185041576bdd4e17a4344dc5ed448578e9e930cd3f7b668891e41347441678320c046a7fa8e8e0c6d01f58d82aceb96a4caf38efb8c0a094409eb47c7df8e96aee7e7ee12c2ff66e34a22c449cb340586340c0b85164e892195a329219d42eed969befb081cc098a8ad82302d017be2a64c32b2af27409423ca7e45bfb6880c6c9fcae0859bd0c7a8dea154baf7aa33bee915743a59dbea2681dc829852faf586344951cf9269cf651b7ebac62787a590fa57fde1a24574509fa0904bb63f96ec2b753bc428dd3a3349bc48a64600640f544f887ee82e822b10454cd3a524fc2d978c71d18a67942e1f32097e5c4e813969a993d198ca44bdba96c0f3b7baa4447d8210165b77cf190bbbdb9fd5b78c5a9c042e84d98ac6d3ec4c157bb224389cca772cd53a2c1c12fd9d4599cc8101280dea09c5deb8242184eb3531f78ba05c6d920f793075189aa1d1588699de44ee1b4fbc21c6ea917842481f30466524f6902768975e2b8dd1b2ae11f8145ea15f3411e9329b4887c64290178f762d5decf9c454ec030bff66c45b33386f54e7648b6c453ce824a56501454aa6e92d0d61c2327e293ed2de9f409c721330ac90510aec4712e37cf51aaae98d31290707b7f4e99e928157682f57b49a265b6d4a2b8db61e4f9b515279a2ce209b2d3e2199076501b109687a182b023a18ce40fc80550e0ec60c847937cabb13ec78daad8433dc45d90dc603c03e79ad15a7faa817b9de53fe42c88eb86105e4c4054ab3d64448ba5d850931e0b8bed9c19f58e3ad76ffef5b6b03cc0d5e683721d9dee76a7350b359a0685110a443779f96e32591ab8439e72dd1158ce1bed45b07759ad95dafe7bc1c953e8f9adc9f379608c8bca836bcdaea446069b6b5140e7038e92d1753c8f604c82e605cd4a4cf1a643099ee7408e33096c908cb242c78e432f8545ba98a770c8e886aba3ce5cb1a377a14581c66c94d709127c41230519cc8badc44935592a20f243319a1e95b92995eaa438b46bb22a6a954520fb5cf5b98eabd1fdb22e0879212573c70b04bdfe7d676c1fbbf948f154825c3a28b5a1d2bb863482144469062a72cc22b99cf494a106e035e0943ca2c87be676a14d045eff7a5cc5667340ab09f2784a59c1e9aaee1bb61a29978e834b46ae8e060595bc0a45d6764ef702835581a0608300a79bb2c1825bdbf24d991a49b11b47bcd44b7905e2f552f24bade4fe6513c85da0a6a977c795964923530a1d90b391fb9f86eb6a50a759de7efdf8240bc4ea8ba020a4a3d713bf2627f117044b3c8ca4ba0fe9a106b7613c6d43786269ef97998cf27f330b9aad6e3dc99a544ec1de15ca7241f4382a3671214265fa12a559ad3220b933cc2906582f7d06863f17ab14317b7dd5fa91bc5d41e4e1fadff33d1227ad1c3265e9dd1b412a120c44f7343dd55ad13ea1245fbe04f15f978a38f100aa8905d42ed4fe4379e0dfa46db43ac1c6d89d352cde27a9e5fa86d399793af80f99c1284422c7e2cc5387c73f6c65f02059f04eedb36b644b39cd02e0e19861baaac2015bb188b4ee02cee88524ccd34d4590188a319b1e2248047cd5db9459b3da3b7ca8ec36106c1f53cbbd8c5d0d07e51f8b207660e39d66b504f93cc4bf47c4b9d57ea166c4d5c048e2ab804aa866a96e4fe0647a65bbdbfc56cf889304dfafbfbc4a4b6726909c9eeba835c8a107fb20fcad798db8383b7dcaec2dae28f06ce56ea8122f86ae606878c3cad32e80fe57131943451cfddafe7f282875cc7a25f1f42850de6d146757fd401ea0f51d123ff3941d2599d0410418ca0ce6d6f8ae972044a70d21b13f4bec65f4f9dbb7c156fc573f1073fbf172fc51bf1517c10efc4afe267f14a7c16df891fc4efe237f1bdf8227e12bf8847e247f12f21791f26928409f08019f153929807c21aa0271375483b956221c54c8a6b29e6d0a78a9b52e99a370823b4e20707678a24a6c1bd3a26c20441c39bf927ead42ff35fabc3b133b1fce0df8af0d41f8becd4bf11f3537f2ec0f3fa57e2ea67ff5264272ffd5362f50a511d2c89e7c00e246b95875f41423b4b1fe9f334e9e7806408b7edb4fd96f9cc91faf1ef212139dffef8e9ca3e06ad41faf0268da67b102469073a265379d4eda636f9af9d8d6e49a6e5e90a94aa40166d2a2b712356e24adc2aeded1d84ea4b2cfd29febc08ee2fc32ce763c7fb225df8df7c2394eac3571c18415d9ce604670c3c4465ce9da890d7c0e7e1823b2e9c3fd2f4ba7b9b850b7a5e46c0ede9a24bd53a170252f9a7148769fe37f2c87a7dbf2cf2682afd01a56ab2fb3a9946132855f509257d210c7daa9ac1b1dfcbc46f555d93741a15b11c11b263324968e4bc7fd1530a202a17e80ca013fece4c8e23f6075e395e10573d44f5325bc631b12252ea778c5a3de5f3303325d22588a47a0989f1bc558f9aa9315f8a70f1293d45433040e1b74fe9d5552c417d88dbc9b92e104c9ded5d9afc4aed19eb046ef1bb25ad53422b721f4d7d67469ce7659a12858dc34b19fbce19f2ec113e78597e5966943e2f8a45ee1f1edededef64c21f02b875c69a67f7a8bf962b40ceeefa9cc664360c08d14b75151809aeb363edd4a0869b57a751eae32625a4a49946d54c8bb822ac4cf667340659ab52f909d105e51d6ff0127cd7603badf654e6e85de0ed5d1dbe125cfc9e1c8aafd805504f446fcc5951cabb4a9a4a58c7875cb2e996ed03e4eb46181eac5739db0c735e8dedcdf67e1edd8aad311a62038b10bd8ecbcc6d7cf1fdfbc4c335e8d36438dde649965af694b4182018ddea0e4873f2d78db906fe7999c71d14f3498bfd6206f0ad5245526efca0d782617beb377b8e710022d0c483271f4074ffafde6b90e51c01b7dc0bf4f2430a03a75ef98fa8a71afdaf724d6f6982bfc9ccba912ec5fbaea40a1c464816bf44d7c209d4d3409f3761f0f93c4d8272ee55dc9b0100d268aff8ef81562a3934fe1e2e000448546f97e46e2427ebb188f9d0e493490559fe174804426ce08012780223065fc9f1332830d4c78c5f3cc4aa67a1215b86129bcb432a8330c38e503bb3993acbecf7b51fe3e9e9e24d38c08c1e8efd097f7c169bcdeb6143b77d55176797aed781d8731b18343e96a1c54fc64fb74724044668c497bb7bcc68ae72fe9db5012d703adf56bd83f2da36e9778ee6e1e1336760471bbf380e4158b9fa0da5ebbb970182f74afd3691877bbfaf8489c52d6afc11694b9445c4ebd1133e1e836771995a80ed7aa9a115aec4689e3ed9ac053cd0788a33ecd93efd2cbceaceb35b3f57fa93dae91b80b2af41cf62b838d781b6c9b2bb94550d401da2ba1b3b0a0b3b41dec91204afd5213f6264abe9e2ac987ad0cf6dd7db557a1d0808995ad043588073a09dd60ba9089ab4ae8d170b5446495580cea1dac642e9851047de0b7224df1294852613004a733e71b3c7dda27060c4718c1374f881aa58b60d0ef0b1c61044ec734ad88dde86d58cc7b190e4a5c95a2d8e7c327dd27ff78eaf983be9ec3af348703c0f1731b1eccc636fad6282837ebb86793317be7160a6a9be8930fd6ec32d8dd2251993f34f3621bf3171b212239ea047f3b0ef730a581f3b78eec11fa2ed5838ea14942261312453e7f7c0d0b0588a5854b02819db186fc5bb217f5ec5bf4a2a87dd644a8ada2d4f33a7f73f614d405cef8320e93afce1ee3bfa006e35d8c279a52eebf75087919881a55b0e53bc021cf9c0eccbb88bc759ce3c3f09943489ae4af5c9e55d3fb9e0f4ea2a0f50b4424cf1be63b98a948d4bfa4097333c15b085435db5d83f809241202893ab76980421f9a16d8306a6f5bc8af5988cfd70c39da88f724edbeb441b2a6c3bef2380391a15b08b620512eb3816f5a0e67dc2dda86158c89f1987e2ae955911a8440d3e760364858086c39dc6df6e02ad881cfac3e41c551a3a49a83c68efb589f5248b58cdf17344986f1ec7643ca411444ec4b1c0800c97fa8ca69f9240c92decdf7e162a834bd71f455c6ab4fe972327f2e6fa20975de22dfebb58d109e8d7b4d6ec158468d7b46c818d26219a69a66472b7983589fb78edc8ca6c7cd2a5baad8106b5d6caff6066cfe951515e18210e5f41420e7ee2c4c0fd006640e6b722256877dc7fa043723701616ed480d7c8140d2b850c49c0ad3789a82899b90f8456b34e2adf29af66cfdb45c0cfac4a77cf38d1ff341b25f56b53d057ddf3c0a3e750eb8081ff7eea984b23041f7bbe0fc1e3c8c5fc96329ff96092f624a4a5ed70c2fc1b40572430caf2a6b11c8aabc166b5a4be7545a30bb54536eb85e55a762c5bb5d4daffe4aadf15faf15d25755a992c51c5d167b10c2d473997fa5f982e05496d7029a354b46626bed5168cf92699bd18d5583c23e55eb9cb0558aa5c16e1744b62aab45c4aa2cedd79b5d45d9e8e081b2092848b3ec2caf4ad822acb33dd10971a62fcb2cae374a7af22e2a5ccfa7074c92bd102562a9aab784dcd6c95c60322f86b7acdc6972abe1b0a0d6485c9c12dd5235e6e205eb93c74195922afd196f74add10293cebcd0cf59b8a8b62d3194ee14075bacddf55c274d7ea2fd4e124efc3c0baf1a270597c4d81ff7be7d3aba85e5492987d3d8f7f1e95940df9846ccd3dbea23945353d44c82ea647eaae0a2adde91dbac57841032f870931be87dbbb37a40f7f724ecc2e43961adb44d235caa68e0a96a7654a074a6a75a4b7fabcfb2f8d3345d12cff809ba1b779bf7b58445ac5914c6d84c6f88f4c5433e8a2b5f5d8fa80e8c16d1d94f29e1c3421c1d1de15055a7302ff6dc3487822e9c6fca163c5d42f779a154b7582a65492bdc56855ad02e2b427a4bdbe54588706c805d8a834cd11c2d06ee2855e52ec90995e8afd787eed9db93376fd6679f3ebe7ff7fdfac55bef3052baf7947ac61a753e644c7a7a48582eb33054298f2eafaf69a1ccd73511a2e9dad3a7279f40a49469fb16d0f32292c4907c1fa797614caf386d6a21e1ad35584735a8e32d5edfd3abe9ea32d9d559cdfbaf0e0e98af294f8956e8ab2ae6960a57ddce92d6f3cdeeef2cea8a9756861d53a1bffeb5c9d8917de7c86152e26e773151acdf0fe546aa70261569e050aa61cc3bbff43678becc943cd61706bf428861bb673dd753829e2c5dd5a7b9c639b919c05b0bd7ccf464189f2d6d47c269f04af27aa14c45a1d96ee7cfb9178d8a6aac10e64913c0ddfc92d60e78a2ce537b606081a0b6e60230b9b3c9b2369a190b0bbd4e9af3d35a354e41feb46a3def56edef9745734b3e3c5683b6c0ff193af396105344ddfce0891603d3c8b277d68e8b69e9559605a9167e8850f487e171360c4908ba4f2084a4e7e185d79b98e39c527b1653ceb85282c55420d9567a9148f7ee3ca60a2cddd7f83cd224f6a2a9b529252d7df2d9ed4ea31c8a06d8a35029a6f3d0aea92738f5f9d6643d54dc83c725a1490389de50c920db24be9c7273b4e0c1d63873c3aa2c7c90b47a003b84fe0798e394c31327aed7d0424e35d6871cd38a7b7763a48710da2ab0cfc9ed6a9f0441a7f3be26666e8974874ff426b11935b6866dd51d82a8f0c1e62de6abc23a271f5e334ec14afe17a86b3bcdad6d71a29a844120edaac520d65ef96ce5603279d56b70f327d9a171a8184698c06246b6d9157797e84e1cc3f52ec1b9622b40ed4b26469f348d20db86719cde7ec8d22b9ad69c04e3d7d7572edb24552ccff5159b16d909357b2dc2ee1f81d9fc8fe562b69e6595f805da1f58169c6af3901ad32512bbdf2412265c3ddb3690b05e024e4dd89f0452948adc681ab30e7753aea5cd655fda5301865465795d53fdf346b1094d8bac5bd502a1f935284d83c688fde631034dc01d33bc7c00a9598613d7ea6a057635ac487cdcce35a60f674cc8dfb32996db178c3954da171c697be229c15471ee6ec936bbd5ea5b700b70adfa599fafa04ea18d30090be1da0124101a4d086d3e2eef7a9d4167dcdb3a76e96ca9ebb9658b8dd952d9a48172876ad75027364f0ca5ce0d1469c626e3e040f3be8036631db148f974ed13b1c84c94fe80fe512bb7f9ec5a4bb82e0ece4b855c89a16993b0021b870f4d190110584f39b6658c59547c04d11e41a5d77af8c920ac1a6f13f3cb81412d27c35c7ec058680e8d770461dbedc356eaece5b6dcd794a5185597e7b6cc19ee18fc7acd53d63e679b3a9359dbccdb4767d5194dc577893f769d8f71e5f63082ff82818155c080d18c35ec1afec021d71d75506f04f74146b1e48b50a346fc6d5bdc9c55191aa58f51f6f7d9f622b2c81a067b9dfe710acfc918c96765f2adbcfc1a152fb6b3e7f534ee4d9356062d8ee6a6375b2ec869606951b4dd5be938689c87abea474570cfaa931f806a1a1f095553efe84b7d9c8e71abfca1aeb1e134fac814dc4f377e82e9f858d57ab6d564dbf7b2d596b9b49ba6cfd58766ebf8ea74b80f6aee3f3e34f21d59ca9eb42d9fdd15f5fde5ceb9d0197487aef3ad96d8c6b79a957c77579a10539b91fc812ebc3dab3e6ad605a7be8c27b8611bcc08132d037d6ef13e51f44a34de412777ce1c4e14e651ae6afec1ab0c6134e93ab7be5ee0cc77c7173d8cdec99b37ef7f1efff0e2cb77ef4f3e3e1fbf7ef7e1f3270ef48069d93edfdd6bf67529cc46d1f563362f5cae22dab1ef75558d827ab62f30799bcdc61ba5ca8f00c78705f1367e0aafb5ca2e2ba236be9ffc96fa7f1e5622485ce7ec6d3a87dc0263660406c990c8f65ad0c8213103590b7723a836e36122a271774213219509de2cba226eddc1bcb718b2d64c97f26d3f980876a71d169887eae0694b334778e8a1fe000d3b1e09f6226b3b8cd547b1b01823f63166ef35163573783b2f498a5c1ec7c3a53a74031da7dd139e2f2132a653a91803663b6ab6545e9b4fcf9418b7246765592f5fc4241f3ab04ea5696fcb4d539866bc3cec73d1cc83235b6815fcf29425c51807c4e2cdedb4814abbbc3a457d7e0628341dd5438517a11b29ded41ec6c03ac554b33f69efea35bb1e0ca31e1e820938add6ce4604d2412172e5b34dd06a0c1e495e4550847b9c50cf97d797ec8b98d76cdcccb963762e2fa89a1dd6ba21afb4bd1244c0341701bbf82f8a3b5eaf771135ad0dfb942e8c7abdcd2f5253b9fb3b5f79d98a95cf9eb59d841624556780707230c6708386c5dca0b4981b54367683ca486e601bcf0d68f1de2bdf607fb0d1cad7a05c623e4feb0e4494bf0bdfb95ac741780196f4512f244e24ec8504de89c77a6bc24343634f91f06427c423e66c975163c0da0e788d225a59148f642381195135353f216610fcfdeb716e54748acad11e623b6de451e1d307db471bf6c63b58bbfaa14285ee150c101bfff2f5f79f3fbe7098e7d6a9b4389f170b999d86b9722d01ff5f0a0dad404d98a7748084d9018c62368de1940871cb022dcce7c4ea5daa5049ee801038c261b1fdbada74c74f0da52a86e68041238543abe173c03022cbd03e2eb04d92de629e16bb25c1c2d53920f00d48e22338fcf1a32c1ea609e5faa40a255f9b43a6de783c87ff9af5ea2e8414f3f5ba807d545f777e415f5e2100037e83fbf1d827ec4999e967538e463ea3a91e8f2d5f127a615c80582078a3cdbd29fb1259eb3b0f06a2edcc230a5277dae988275570915e11c0ad693c9e20cc087e8305bf07e7c908d0e64f5c65770c57c96d0c43157031200f09acc68fe032184da29e82eba335b9500db1f40def0a0cc4db5c789cbc7127504094e3c92c2b65dded236fb86f4f6e0eaf1a4cc42b51a883126a4ef2d4bc22ccc9b38b3550b3864d52d61e2abd6f4a6870dbef673cfee02997405381a1c531fb6b3512732b91c8a0f612b44975f551d8fdc7f6c6c71b78cdb18fcf18e5cb4ec61ca86dcbd09b2a5c429c5dbad68072e53e04c7cef1d8feb0b4d9867d3ec4690cbb86636002729e20264b55c5c4aea2a53fc508ca5bbfd890100d37e1cbbaeecd801a06a87384ad3932c3b76823747058f3347fe3f0dee1c91137813d83995859efd3683693537155cb3211b7d6fb32b906ad18d62aa91bec1c1cdcc0d86baa776b11b80b9ed48987751c2ac6bc01144513200abddec46336fb576b6d7570b0425c0ce3e049cda005147fa57fb86a8304dcc13e2c3319a001f333c2db55033be253d15aff592622816dbed9bb34a884e0ef0e0e262c04d62ba25ed936965029a6b6ae3511b0fb1bdeb160d5da15dea89e1b7ab5b903626a38edb66c5fb36bb776ec3cd0c9510c738416a208f7d4f17a9d6ba7bbd2d5b7ad19a910bd2bcb55f640b3b6b677a2b737efedab83832b1d066f0b1e6b3071cbcec37598800d9b8607464d0632ac71ea2ecb3a3d625752dd09aef1aec57564af75218814ce7e8e1e2685492f735be942b575597a025155d293ebac7ebebb72b400c571ff2a018a440a4a845446547dbfd9b2a5add47219b14c499b6b9f8d5c5238da4303d50e6d75afcc7acc2c7085a71c7c40bd17ec5204ab6ce5965979593998f16b7a9c7e54296af9f21dc256fd74c523c969a750d6cc3a69d1e3b6db371c1df587c5f1df116d8526e0c93ffb9e8e8fa7023612759e4577723a2646b2607f4a0f2eebf0820f231312c527e23d9561acb9496593e6a722078b9d5cf9ff44d00c007e1ce4aef37f2e5724ad71044cc36064bd45aabc35f3de75b8a8919dd4bb0f354703a18d63a746d311ff2a9f06e6cdf243a7a3bf7588ddeb24f4f71f4ffb261587911da77bb9ea3a9db8e3f47e5b5c397e5532e132a64e84567c35dd6a6a3c9f3ed4da93fe37fffc1f9bfb3d5ad45b63b3dd791a4f65f6509328b8abc9fc064d9a3091be698c9e8572c0cc759a7a59af697de45d3453a978126c8a55562e4a615ba5b1484673d221915b7541197d539260495b65e347112531818baf3d2aadf52e6beac0b154db8d3733a85495036e648dcf2bf5056263f3d39dfa84388b576c656e758a3d3b4c6edf092ff3342661c52144cc9e9593309e2cd9fcd60f85e938ff56dd540f9b0db19595824624bdfa0641c202c88cb54dba52da127a3ad27aada9a9b51eb0669788e95611caf49e2d7a734fc7ba137692338d6e1c719f23161e0d69a2228d36b44bce46648d4d282ddeaf569f564489fbaf72e5279b7a63c4bedd434ba1025fbc9a8aa6cac647f441fda23f2e484484a921c0cdd6d9c0ec91e1c8d28ea05e827f91263c0d7edc685f87292c070c8b2c00e106cecd0ae81be3bc6b1fa5f65716f7a083fe1d5b77895ac8554ad45bcb8eb86a7c1c199a6a35e21c47c5fdd063a8c2baaad96a542f5bab37a9c6d259847161264f0df91e940ade8ece25c9d15f1d0dc50e7157ffb7dc6bea6d234a830f2bf82d68187bac463313ddb95afad2249801ed5c652300479c635d082c696e9e6724cac763ad0bd46921a2ffd22a99f77cbe847bdf385f48da213a31ca53f38893a10bc3a07d97a6c46f265eefb734228e60efdfcb7efff21f08dfc987dac4434d6b74d01283e9c538a254a1bdba83bf7b1b45fdb4c49f83d7a0bd2b72430fd3dea5071e6419e49066a7b5f4d61ac5229822eb8c7e06178dad9af642cf7577fadf6dc71068b21038230f7ba15644896d7cd11297b4b5964d134a5b10c80494df200a7f291a6cc0424c16b40de1ebbd849a1833686316af555d4d0b53b691c984489ee7da8d4ec5bd5a0bff5f67efdf296f91ad58aecc4ec0ec630253e536ddef825db2f26d757a170e6ebc4777e87f7467622c55a902d2c7061cb2d270b13d6216af9801db089c04fcffe2a36d47a6bd37863c3e2f1d98427f575ef8fe69f3049fad15bc8ed3885e8b00a65a81469b6a58198fc1bfaaa8b9549d6717070712c16cf148edb0974b94735462bf4dbbb867c2d49264c1b9080369bf0abfc507bde27d4d2ee635dd82a838c85aa511631d4f89cc02c971c92bbb0d7f97a6d152660fedb3f53eb41d23e9ffb97e7b239431e7d6ba47da249657002637c23ad86e1ded9f86641e4a7b8cd573239071f97af89f7fe7ffce1f1f0a3bd8f121a73de2c48d3007f88d0e45b65b54a18ec7cae63a81fd36a226d9030c3bc094dae5553fa9e245d446fb9f358db1315a13ffa92ce3f192da467c8d662acf2f89d864b9ca3b4c86acbeb3c69420ee9a39621f269031e55d71165d12af70b5d9e0042124512bcc26dbfbd934023371bd1bd26e7788732db099e7c905edf1c2d49e6ab0ea0e8805615f84dd1522087a513aa55981f261fac1975040b9b7fd0918394a48b242fb41417f680832cc2186dde7cc82238c61cb8664474ccae1cac72e3f7f787df804343482435e4b89aeca36497393ffb1f4ba03eff0093539595e46935d6d75bbf231fdd7196c28e75416845d5f1ac4553fc68e4ad344afd4459b94a1dabd51e58ee5f598ed1105bc40137d3a90f4488c7afda2c21f84b71115b37c6d9058e2d50b98b323542f3f1096ca0a843a5378429474b54551c0b8270b67c14399200d5459daf46350816a3ba917caa67f5fe7fe60a5aed755a0e0ebdc7c49d8fa55ecd76b28cf65b6430b63920ea30fee3c4de43a9d7a5b418871b04f8079eda94a32ab9270b1f809f1afd284586928960edf9fedb9ff9e76bcb1fa3be29fd121a1678473d5a7a3503664d5795ba68f54bd67c180727194db44d9a2be7e7ff6011d8371df4699efa6a6296da7faefdc3def77bffd77efe2b17708e550380aa942bf3f7463d5c64be26511db5cd5efc6c7dff4bea99ad0d5b0256fd20bd59b1e55102b9bfbb7e96514cbf7b4cdc2e010bb2ddcbb268e6bad1fe96be995e16dcc26e65829c1b98a45850974848368610ba95d25aba85440690eb8c973825c636f229cb7e91f0873855857efe9f38208efe2f89be102a6da05b1938b8b9228cfe8dbecf86838a36f7930399fe1a4bde8b8c508a6f8617652b87daf7e66d7c97b39494ed21d787eee11c49ce744c4977b3a74235e83a5074df33e03356b630baae34d7a6bea1036b89f13cafed806f1d47753830dfbc87fda02fece85d1e0553976d44b080a66875cbb02cf6bb8fa72a75a3c6c416838280f7b6e13af8711048cbeaec33bb72f067fefba45f79a5578c6517d877da85b203002952f598f6b9a70e2b0367a83d70c696dc53851af4d69d495df5cd1f66ee76ede9dd1dceffce6da314b6e8f7a697675f8a4dfef1f42f5231cfcf57499b39fbec731bba8b06790105b4408b257c7bfae67e55198938fe8c0bf04dbace40e0e11be07e02f612d399983bf2cf8c1b09824aa06358dea9cb65dac8414c558c3964ef91b14b6bf01480251b5f8024f5bd7f8f0fd005655dae981e34a26e70e8fc6e944e6f2a6fd8132dcb43f052151ceec024e77f42a6aa554eeda675a7f739d08c7d10fee95dd77987c4adf118de7683a5a0bda1b3cb1cd0e44e920007b84384d171cc9079e7e65f09ebea8d9415909b6af215f2864bd7f847998dffbc753d1ea19e41f1d1d89566796f2cb2b2a673a0a4b0bc4dbe30b19f2c90f7255061fa2674ebe261950f5f045327d09490595f58e9e8a4598349322a5b50295d290dcc2213827355b0366f66ace7d3b984a1c5c365d098f7bff180dfc41efe868433dbd3b5b20721fd78134c16ec9bc4e347d922fba71e0918dbda13d4643c49f630f98ddf1afc49db814a7e285f8245e8b13712674a433f1ff5194b3e0feceef8b95dfe7809cd5cbd47e5948dc053393411f574bd0e3dcfe3a46fa0d07105df1c513579ce7164625e24e064db65f8f3ec3854c34f5d1e4ad2ce6e914f19ecb3b2236e2b22dcef4af9545cf9e7c56740723d92d7c79dc1f151ddc7b254eb9e917b2fdb68853792e713b0dffe2e84cf09339d1dc884f6d8d721e15304f4b51cd3b7198dc2904535d7941d442df9a31acf8f9fe30ad304fca126e7aa12fa080e10f2e7a902d91695a08cc469cd47b1b92c026b2dee59562577bfa9682403e0e7b2582d88833b9857045e4ddbbfbb788f9cd771d0459e5df8af39bc3201a45a59dbb9f6ddbbe7b429e9f5d04ab0e6bd3c51e9f232cee9cce55c7d9e31de03a9db4e378ce467cad77bbe0c07ef0d978d652f108bd72bf706402e3be416005a6ea96b937ff16656b19d4a70111db3389a89f53d9bbc39f951843f0785b6f5f564af5838333a880ca77cdf74bb3f73f68a53e55b79db6aad24a1cc15e2dcf9bd058a8a9926aaafa3c4d1bf1c7d64e21f212f680c10f0e4ad09b747093cadde399ec4aef108fc45158c16e647752f4ee60a38758ee295c7d9367bc6fe031971ee3b20a5706c8d44909305a71acb7c17782a6e738c19dd218decba0557952d0d6e85ef306d17b6c8297ce92ff26dde4b15b1cde50052f9b1550cf89c8632257f4bb82c5ed5445789c0678463093e642ddd5464ac3e4d2b5b415028b487567c3072bc2304dc0fd47158af821e75dbe22b1e905d8ed92403cd6eed622ac428900e57d92b64f1ec11cb5be83b5d3fd82aaaf0f65cdbbf649fda106ec0b09d326cb0a4f522dac7bfa55b65dbf82ed22ed0ddc02961bf1f35f2e7bfbac3f0298d408dc46bc922d6c9b5148043606d96a7d442200a1dfed1c662bd15715f490f3bd070c1282c1cbb302b725d183aec3bc72763c1072a5aed2030b1a2a07bf7374e88df8dc86e01d6718f6140f7270f0e41fcc217c95ab53a2d923faaafc57fcb057322754f9d1563618583afed1b7763a8b360e143f800c5ebddea4c862aa049692615ce827a614faf99ab85b7e74e5ff60c629b2f3e2c2e5538aef1afb077872bdfe1e06a7ebf5778c08b6ea132d81e2a8aa1fea44a9c5872d6af8b089df9914ff06e6e0fb7a477e976a81f857c96f7f542f1e95e976858efecec954db97d62abee7d058bf6bb2fe9bec74d44bc0114d7f92ad31bd2088fe2ebddfb7f45cb88b806bdc885fda88a4756d9ac883d77025fbc21db0ecdd186dab4ee3c98d395f37f79e05a9d135a95e67248c086882ddf0e0207471bba6eb26ddc27b1cb9f1610a65a728272578ee2e71030775ed11cd2bc76af33f111bc7da25ff85143791bc856f01074525a4a1ad70fc9038e3b72592d7bc39890ddb674389e287cb7dda9265acd4a3964b604ba60914f41018aedadbf91ddfd14afbe71f1172be065d0da6fc5a326dfa185c33ed600cac7cbbec428e606d2eb356688c64a796338b299321660e48728501e757095d8b685ef90862cc17c6989b013924758fafe0ba4db3af014147e9ada2f93075ed533de88b7136bb8415d27b5bc32ef866de0f418be8fc3cf80020107fd0ef847ecfe8b7d4fe8837f4c6d224355a458878a871954b05bf453cf592e1692994b584a929f323bc41906d314c590fd1765fb19d491e5c06e7f7044d7636dbea1fbd2051828de17de8b077661efc37999f6c67be1097703990b16690f52976704999b7539d244d8823a8c3c099d9c3f49d5700cbed74dc0f3d4b034760f276e41c4d5dc7775c476ff2aba09667e430e7e751169cba9c058e0ab1d4c67d44603bb6b948f66708988b74b6b85a63bf67f8e867031256ab57befaa6b96c69503c26a438d4a1d1f8ed5619ba24da762448cdbb6a7c9b3336df11f2ab8549d6bdfd2a1b8e658534c7660514ed160394f02d30d628aa5798f73d2ea8c752f7186fbac7b2d163a97a8c0d6f12d045ec7cd5276057310fee95551d0195c629f422d20c1e254c0e95f7a4bfcb38f59d27deede2ff4852bc63e1aada51f645645064576dbaca0d1c7ca2caeeff00e4b4424844ffb3547196fdef08677fe8d5f4bd07071672205024d8aca9bcd7eb0f3505b57ab734d1388a0bdbc39204e1aea830d0772216e24adad10fb3738e4ee27456b0eb864b1f78201a66c427910507b033de2e8cc8f94ced33cf82037bc3c97a1d2a4799f51a1756b1ebcc84ba38c19d841325da787cb1105f0d51062278575036f1678347c91b1616b7ae2608b3282c23c63ab3302eb9ff691873315cffc328c3187b050e1b353abebbfda5340313e61bc0afce3531207a0a61f039fe476a07b3a2b7d19b7085901d2463042f1b453d6d94aa510602b0ee394ca4948cc72805b27c27b012707909c6d450cea97cba2a055c72acd50928401f09c1a9cf7c960523447e4b52f58e2c0db81c397b2acf24cfc7e517b625ac213d2e99df5c95f9b5c52156d7de1e62811b2bc75ad653d077c4504752ab9b7416de63168f2f712f9cc1eedef00dae976301b046d9ae45e68917b211abe745d208b3c29cafbe6cc305351193ee003dab2513ed997428f9bf2237da942aecb15a14c7b0fcc09af386c0aad180833ed7367bdbc8cc1526594d742d67a1550cfe20cb56f5e9a43636de5315ef6d2144ae1fa06ac5db62e397c6d4100d9f542b79aa77abdefc21aefb43381e104d08a3b73b03d3fc469f7f5faf7f5cafc7fb0f4a9bebf516561dc823af5dce37b778420fc7629f8de763be419024acdcc8fd26a254a953f86f266bab90b93168ae57cfcad2bef40fab32b0860de0d0d97781c7ef561edda919d29b048e196e3bb09a277eaee92c7847c2e8bda76382b1cd9c7ab467141599a8619e7859e8586ca6a997c59f61e522837596b06d56de8bdb6648b75b6baab637fdce75a06fd7204b3ff155395a7783a3874fdb6edb8868249f49097d44ef6e4412807ef4e5311ec3bbde1d2bdecc8b270a937d352a4cf6955f98ec2bd61d981744e25162cf54093d5f59036c1d4af90f87e4989fabf0812434978f2ce95ea5b5e15841832f21a67addc990706cd124adb07fa2edc4ba487726bb01dc1378a612a585d8dae11b013d484de0eba17117a87283bb266eda3e76f1b1aa8bf132d5e7371de70e0e18fbd48230c19b1abed1846c1b8ed46698cdcf84b087058c3f4a3b9ec2083dd57d21dea850c89bcde1d4f15da1e06378138c1fc2466a15a1c3b3325db20fb5d753617eb5ae1a4baf1356428f8ef1a31e1ccba804dd913dcb353b17442e2a8f1ed4353f477ccdcf25940a11479972cbe75e22e534579c175b4588fa0236271ce66b63cb5b8bb5b0c4e4e00e6f0ed98325498e9f7858ca0657a68e86b6d76b0c0b9567c111f56bd1a1da3b54d9b3fea87be41f81bf3c6a9ea4247c84c27968af5c9af316010a0b1fcc4e071a6caaeb89e6058a8a11a813a682e878d2493b038245dcb642952d525caa7049d841554bc88828042a5cfc79659da49b760704bb3c1738e24040027b8e8cbd24cdc8295c579b9082e9c51dda851b8905b5f0851e3c92f25c5cc5ca476ef61633db02982a98886d60d998f564fd901d9981c32b510735676bb4d075f612a3f8b81fe8cb112bfe559df2103f8a4b02087f5f01bc8360cb6099bfac1a5f94438f96a8875c74aba0e072dba54a2e5a4b7957a51c473d85a0b553cca2d9a41a5bbeea8a7f0012c30207a8a6639d2ee0fd3131cbeae09d33ad90695562c299f42cb036de58a2b4f75c58a678ead6238e5a71c4512b00aacb926d7553424e085e5101591e4c3a4be279cda110441fbe353907aece0901137f42f0a4ae87248129b637f57abd1f1b5433e240df324cce708106fc146b009c8a9cb8a4405d526deddeb81de6f8eed4b88e40069edf450da912de0e0ef26701dfbcdd6c06410fed1338407c6c203e66888f69172100dbe6cf906b3b5ea53a15ed940f60d8af520d03aba7fdea708d1a0701f6dbeedf85204bfd11d3c21c4b6163e3ecadabce1b0b7334a5d2579cbe122f25eee543047c1d36f21dce372080c6b02c7c25116a84efc4a229c0dbcabc69a7be311cf6d0c2849517c3379820e5b95c8bb882d92f46ee58f10c77416c861d535f7c4a776537f71e179d5c1325cad05d2261a9f251c2aa3b41c2842f9765674b9a27aed8db0c93d12fe081d945f1579e28a2b8628009e21bd8d93a14a69f5003bd5f1662eaf95377c0610ef86ce05ffc5722de8228f86fc27f53f6668df839e3e7909fe382b05e5e58a6074ba44cec94a9fdb2b05f6645bb5140585b409a892e16919ab4d78fe6a38b3514ca9cf5f7ac7051ee31fe7490154f387bbcaec51dfa8980e40ff727e28c74a45c31e7918c77f4655e54e196db0371ecbbb868b7a02528bd32a1e32976058d2efe2c2e748b46f85977e0fdb5701f848ab6ed6ed426da8e848dcd3347288a72901b71b36322f8f8994de77f115808f5fc854f89a15624f6bfc04d9f99a04a56c596132a71de41efe9632c67879793786b9db0eaa895ba6a8b1035e8964b0ea4b1eaee3ea5a44a0e411256ca9cf99617f68eff5e32d09e165b77860c2f8bd25675e8d10b3319e674f7ebc8cd41d8d3a2fd225168847239ba2c884b0da43fe057f0700571b160c873e2eee0a1e43743852ba50c1676249519b2640d837eb4ed6755ed37b08ad75f5949cfa25823fba06cbb969d44fcbb02bd706fed65bcb5d7f19617d271846a0ed55fd24abe28825df704e2c86dca5605b039201cc8260c05e3413c7623fa0336c5cef6d84d825ccb597c46986bf10a47c861af61464638d80d7b754bbbf57afcf06936dfc42c47ce1cd1cd525c4c768727c907ad2e3a01eed4ea045f12da6c5ba44199a1cb2614b83ff438e8b3a2d3d3b47e307211b46c02d38ee5330c98551278c0a26b9113ad57226714e4580a6be0bb3ac0cd76cb6c55079e6d750090b23cfe2f3ae0f928475383d3f17a8c49f04550ad100f15b35dcf2f01985fae53b45759968b464cba22ff179a6b56edc3a6e471e2593c573c84da9732b4da173173007009185e601ffaa9d8ba3d5c9b78b0e6451910d2f6c20652f755d004c170f443e16d1fa80f4d10cffd1f0836aa6af64d35dcc2b8e0fbb939d4e8d6913ca35365748f4bfd88a1fc5a45c0b1fc48dc146cb1cef97a2a1c0e8c54005a48a2490deaf108c19c1717208d666fae7cb331a3a96f55b1d92861e0b450375319f179f848695d59e0ff9d841bc03e2dc1ef4124d555dd5b4aa4cf414ccc990cbe0b7e09be04bf05df43044cb5f6b6d4fc73c4ff33f68cc0bd6325b3249658e725d8923e520bc2f208de86e7a420eec11300984073e1cc36d0f80ae087157e569b0bf173f02b9fc60b62b9c60c6ad7b66f235f414b83717f0a9e63b8273859d82084eafe8f30a51ac0601d877bbf68d68f87f0238d8546cc5de34ef6ad5ed3d38fb2eae9bff03ca0e75581747a5d50c7eb1ce462170bb9a878c87f05335d01dfd0fe7a0b605b80e8bf011988ac60024c002480cb30b1707952a1f20d04aedf8d1f08030aca03137e59af7ff41e0569b90580a7718d9f169568f5096df257ed01520e1611f2c089f164d45357485d79c3323583ff05c79920002364ebe038e2c611d432cbbd2745e3acf191396a7c64dd86ccee78d8c56acd1ef19a492ccd8f52f704af2bbcaa2e881f7103ca401d5f9a8ca23079c4be64fc889fd5c1c10e6f3548cb9c4d89c70054f71148e8bf4a34395490f388210744e57ba37fb6ae58e13dc3066826f8a2811091047f407b314c9e3d40c63a0f7c3b1c3c059a67b5903e661a8828f8155b290e7e964a3d901c4798beb067db9ad3f011fef8e6f841127aaf249b41d78dba097155d1e1a0f7c41b9e40a8e581d6afaaa1c40c9d61cd1bc9c9aa54e43143427dc390a36efa98ea39d2f83779061283bc4937a616fefe38f26af9e34e4a49c3f458052ce95b7b342f14fae90439f80c6c50deeefcbec23b4b758b827332417aafa4b8c45392d47b25c625c082c9b3b118078952e032e88336a4d22c365c11d84e9175649500829da0e11ccfdde091da2209d3493bdfcacab7d2f956c8072d721ba082af54b0aa9f5656676827418c6705518b93c0c1015bb55396b0575aba1f1cfc19d374af26106df114a94706ae65706559797f6760bd790fd29210b474979ad0f36c6ec5ec0126ecfefcec69dfa0a7ac64bf9f8ce859eb0b7d92bb53b6034ad90c282b944578ea11c9909b8dcb4483270df3e5892fe8549bae8118585e78c9feac7859f18b20211df400dd649c74b685b21be7d248fac1043a5f2e2aaec32ccd6e161f87e1f631ca0f9ef8619749c20f417fe389bfe3364c26f5ecb567f11b9f6120a49893413b73f2b55218fe295d4954ef9817817b0fbb130f38e2eb6e9743dc0781a106f7dff83aeebb78e2ebf18a23df59c8c4d99c4b3b02fc855065c081abc2b5cf861153d73f3cf47da355759a03ca83d82219db8bc3ba38c21d4f209a192e57f34afbfd214b6c07078a678999c280ece4eb35c865c2dda57fdbf58e8a766e8d2387e8be6e10cc84d5bdd34f9584574fa1265550e93bbfe593557dfb37d3a09afb8d5697f1db4715b99ea57c4d83964177c094558d9a98434268ad078ccc86fd385a067dbf0b5692aff05c2acbc9250e969605df0a9c8f9044798815551921860c9e12cde79bf57c27bf8d16d409a2ccf93134a33fa24d3ded84d639db070bb41b54545d54460dea35b3cd656924ccee2276e6abe02b5f80f4aa8abb738696cf105e44c50ae602c064c7616fcb8bcacbcacb084aee47a9f3c43408e5108ab59b1aba8362eda80fce5aebd5d813ba47fbb91e645569ee1ed25a40a5273b13604f77d09de265aa309359d026aa1d5484cafd05d3e1294a9133967a0e45eeab9259d99ae40dc3b55e1990921118e81dc2d8dbc27de5f9ef99f3de88af5bf7ed31620feee3302f5e42eda40d44a11c3449cfa3bc9ec08b82949c047b0850ca8ba599f091105b964b4e4789e7724230adec9baa12ad5f4e2e737c9c462af244d529c48782b1d47302af2851ce7056b266da90d40247be7da14845b94806976a6bfcdc79da87ad5c715e7eed3eb9384f2f48ded7597ea5ef097c8ca0016bcc1a7bf2e36fb7b03f62fef0a96429da0ad6aae3dea2c8762dcf9ef4476de50fa5df176d2d98babce39ebadd7cbb15f6a7ae2d1d527bdf3e6d26eb15c5d74177bb08b2b72e2717b09604375ea838d758b4569be4a4d758536591ceaa854af533aab25910017bf5328bcf45eaca9a870a69554da9edd89599eba9cf00d5d0fbc7ae39936d732677cf196d01f4bbd77fdab66a541bee8ddb9e2330524076609f2652db693b1d48cfaab5f6d1082293356cc8a70c7564580019b20b88416eec1451ae6aeb5aee5cc99db02201f63bbf3d6e99f5cece29efeefcf238e10bb79e47b3d9e1406d80079621d8bdb3b8aa9ddde518b9352ca6c6f75f5562f55568dd5a4bad1e9bd2609edfc047b92ea1ffaeeedf2458603711f3a23d21929e55125d4ed25ba5d8a95a0e38b5abbafe8e188df2497d615ffa2d4850a47bc727d8287d55ad3c881ab62a69cfb4721e5cc6de9dda4c0f6559214ba94d9d361ce4a6ec21376db8c84d5982e17130d2f85e7340d8777cb39d7606d988b72d6aa55676072369958664dbf6e533bfe62643a275d85687371ac671c093b13bcb4a65a9ee147960628387be42c128ffca02cbbfb2c0c4f47fb12758c8120c1960a50dcab0917abeeb0424661d0b58a9b808269ea8182a69f42790ed591f80101d75524cd034e80ff36747d00b2ce1cedea0c9bd3b22d5de087cb59f1f771fc877dc7dc24ea4509c6ca03f77279d20f58efb23771228d38491f23725121fb38bae36d3662b6d9da3efab3c2a07ac00b4af2b0d702ca94201a332f829e058de04e62ef5bb334b2faa3d5e0de0aed78b67ecbe6acf8186231cc54ced53f92d40a31cb3c387327030035d07916c772abee9e302c02adac7543c794a7df6a701b2c638ab9df0990b0ef939e48c721aad3965299db7c7c4b0fae2088c442cc4b48df1ffa31e6bd8b2bea346696a71cf628ca0ea13aff22d6898dcd67b51def0c7b240d2528aa3d96cc41f459bffe8e0f05f8fe5e39b8d78bfcdb8076362457f5581515865383e2e463228fcf133362a0af43d9aa90821019908bed0207ed65a4494704bc949b98822831dd88e3aa3049b10824d0873dfccdce52c498a7ad22e43a55cdd66784753a265c1dc11f7b5f804fe3d6429f3753bde5b9b57fd8b40760af189fe26e235fd4dc549108d6427f21d673374bfc229c80ea7c4ce1b90a76954fa89c1e6ebe8010509f5f9ed994e7384c3674408f2c3b1804802133ad68de3f9956687529521b1a3cc859d25324e4c46dd38c91a46b54418479dcb980212c6f16509f196b7baf1866eabdea36dfb092e079dd7fcf7445c072fc457b84bd170df5af19f681bb1ea8107953f1bd44352bd6d7cc5126f5d181dbc15f3f31717c127d8be7eba085ee3f7f545705688136a6d7e7e721120818da4f58c5f770267af3aa213b3f21d83d8336a1931ef959902346212902b406b2661b9d06dbc659f9afad132dfa8bdd1915e5e16e24d213e16e24321de15e2d742fc6c5fa0c4f06414e5c3564be90f0530cb47fe5b462b511e2323370f1a49cd2cca3ec5cf093f37aff681e6b799e64eaccb1248a4dfe117e4eff023a285b7b6cff775b70b3898609f1b6f4a1d6ad43262617b387608b9bcdaf99590c3096250f17dd849bbb745bb1780f6789976a344ab88ecde751081e8fdb2806f88ef281f1184b385219bab9661c011e2f667ebf57ebe5e97de3cc47a18fe6457a55c235f331b6c479cc129c354562b67743a9a9d938db81f2911d9815073c02e88b3d10e2df035bb45cc3cff1a8e44d360499476df62e7381e7a56bf90f6459621d4f596ef90609ffce8356ea175cb67dd351581ec3be2fd66e144fe046f8b28465f9df23e471c238f69aa6e0f8bdead3a4dc98de51a7167dd97223b5f8c1c031c34619757ce4563e8bd7e7fa094ede2cb3670214c1f5c1d5aee8b6e2efe02d465149dbbd3eda8a6e5d5aba2dd538ab0e88ef97e00e8aac2b42247b0487c59ec52de6b55fd8370941af5bc99d10cca5f3e5e5ee167b5217a3b46743b495c63abfd60d2b20a1f95056102325b74426d414859ba190139259546962e67a7e4954a36c2c762d484d741b7f0095ae36ef13826523c65f3427b70ac049db591efa5b8a60e2d01e9f5e9ba261cfea46fae52fd1f3695da3e2d9df5d597d6e636c0604f9efadfc28cfe159b847d6693b0efe859b9239390810df436bff2ff763c8d6ef6d8302f30b78ee35bf73abf729e1d877b88761e38ff7799c5ffd7d95386778133be8cc3e4abf3ecd35ceef10dcdc787e1b3bd49ba8ca77b495aec5dca3dbea57ada3b3ea4fa9ffd4dccd26c22adaba3dfa84b9f11894b5f69ed9f0fc400f19bacbb7c5b3cf4df186de366237ed8b6bd6341915006df425da20a7304b7cfa78a29db91df7c1f2e38cc051e827b84e0ef8bcbb428d26b1fe7527c10d576353d2e667e55e80832af0a1542a6abea815dba79547555c18951e650f22e40a943c9bec346fd1c44c7d928f2331593cb3ed01c3a69165d41d88a4709adbd39f38c99af975618a4840f959360d0167725c09d2c4a36e661abc7e05e5921fb95ad2af13ff65b94546f1b1d99d0e8db5b43379bc9364d0cd3d2f4d9d605b8988f6ee11d3e21802d6da19b3956385d7fe275aae915a932c50a8a67a8616415d035fa55833af32a489079d5c88ccaad9aab722b9483e99769a4dfa813ee52a6ced60a362e4237dd3ec6a4cf1f278cf8133e526bae8b8a3b54dfffe5dce9fabc72e936a58ee19632cd595fb0b5d216540dc4ffb8d2e24f3b55f549fc5eb4452c09394e4045ae5326ca8c2f4e160b4445e720465fe0b4928aa2766a7f7070cb116343cec8412170241eeebed00ee6c60ae9e0c9ba6c845bb5dedb432dd4b2688369e5034f68f4b7a2110c4adf59cf47f1ba55738b210726b8beb263f85a375d0bbe27c1abdf3065d537b0ea33751bc178547fc5cd63aa293e2e2c7a6982cf011e1887eb74e53b58467132d9125165dcea0baf187a90a80b697161a7bac4a1d888ef8bed60611ae95aa5f900d8b85678760c292bf64375dfbba3b45a2d5fc29ea156658c6f4d8f04b7ca77c81114eda405041c38dcae059d806a85a1ca18152534bbb77e2d9cd7ed631bb9469c655ecf32b7b31036af81ddbe2c01d2952d10a8c246942122b63368f7a248b9e68ba2ad5cd19697b6ef4f4dc3b3cfa50966158d8880033725959f8645a7e3b932f80ca33bafa73ba36e1255b88bdd697e47cc25c99e7bac4dcde5f3e846302c0a5660423cfc500dc71b3207b2512a0e7d517a0c354053c9814b3ac01a6c39fd698f50bdc3deb1a51f8069bfdaf4fa91b6d17a7de7e9e005c44e20e2d0f3b00895798002e68383dff8522b156ba7ea51ed28a00a95f95dc1169b2ab44c1ebca13d458232a12baa1e5d3d2984fb6ba1c4d78a7bf15cef18ee844ab7c8aa94175b6e876edbed73614f3345307ba5dd1bb04db77c46e83eaab47f1cc1864350100b6da50e742a3b2c16c1805618916b633ff2788101fdd534bb934ed1cc1af9717b56a2ae50cebdd80a0bd10854d0b400d072fe5f96ef4d233b348404ddbb0226982fc6b5bbd6b126e0bf6902fe1b05f8b64b9885351459108d6d5edfdb659e8885d00a417339b5aaa694792bbf5bc8d32de9400d230f782fa9487eeabe00057fadd751b0d75b793afba6e0a358fe21c18bd54115434e72720bb78d8010ad8cfb7abdff1602b815d341dd5bacb0d3b3c1937e9f6369697fbbc691b5f12284b32b6e4ef4f4fdb0d68e7235baf1864a87d25805adafcd6b450a3606cd95737f6deb1722574c315059a19157906b5ba1bc1685aa49b72de76b56ade40a83e4bd79710df64d3d98b8bea3658d69511f3d7f6951349d46841436a63fd05ff6395415d344da4c53aec1c0d3adeee7352871d387190daf293a12f70a62ceb581f5caa17e66848e30c0a9a78d82308d356ea376e9107d8d8de7bb2ce983e204bec75597383729a381d7aa492a8ee507e4c4c0dfca321c5d55dba47e0050391923b86d8d891ced7f28705659df95c99ff37cc9f6c6f56b512bf8cceb43e18d3e6babcc7b808d9f084df3fca5a0d1f83c261deaab10aa3a5f8a2619f4894ff17c9eeb84e63ad1f412baffe6247154996aa658d38221bfb17239cce510a9b7829b977d57b4641a5410b1573e75bbd6b01db1b02168da21d0c0556423c7f19d1d65ba5d25ff0b9315a0862839439500177a0d65d7cca0319c2d3c51df1a9490d75660b1c92b12fe1b6f8eacd782a8f8346fff63518b36d558a3bcb14679eb1a99f500ece7d57a789b8f7074c4e9d5e82d0cc17d975665a94395fdccbb065b930aa8bbc4e21a27ab52eca12e4da0f566d68da85ca1eb0c8f4d400ccf6e5e2af6de130d3a012942111dd914b506a5c2ff97423c2ac48fac1cfa57b1e316cd66887ebe08d4b16e05a5f54e99c0db378532c07d225c89182cf4ff3d94172a4808d5cefa23bff4e0cc94e1269fa5d0aa5856b07eb25e97a69ec21468dc529aea43336eaeedbc8c3ed844ed459ba74f86db07c305bf29d6a16e53aabfebf707980b359dbf58d4b9aab8b1b4960f63ed28e517ea81aec014d7edfaad07f3fa76dcfd2ff4ef37fc93e63a13b6a5fea578a001623333622f7f2c6c53b8bb6e0a838727b6b903cc3653d8383c795a779ee3083a26803d42d65476c90ebb935826e3aac4bf0a063163e0ccf9bffbfce9d3fb7726343eafb2a6a4f59b3de0d65d5dda6420c8b81013d8c7b25b8046b7b4e8c18d85469af1e877681074d66a0561f38a43327a02943d97f9d7225d286d701bb459196ca87b43c2e7dbd18b5aa8e6edb85ecb85559c2dc6fc96741548b8995c0ba9f10860a8d929e7762e65acce0ed5e3f3f76fdfe24d1ff10f5fd4a36689fa4981bee12ba14da0a2fd70d99f5153c9a2d78231d5ca3f2aac984e0fd492292e122391d3d78951a4a4f50b351ab9389a76eb21ca1f9c431da16c57ed89f1f160d47608c26c1e531b9861fb0fd45ee512b1eb35ae344d77f76caae3d23aea3265c25e2faa880f2254f369874e3169b6036603749a93922a17c8ede1558d974b57f959085ce54efc7c15d7a95aa366f09d71cd9faad47e9412838951b85ffaec286023928eb8696d71a4cf6ad655531917e117155d64c9f7571bb30b8f8d9a945ea719289a281a3b403a5cfc175c6e25bd01a3154e812a7de472a6c13f1febc45f506a65257c2152ff48798edb19caafa53ba1da5acf91aa9a92bd2a054e9a5c4bb737f8fbe3da17cf5458a57d196d65fbe26f25957cf9becb77d044b16ad7e8e2cb7ee2d3c678996a85098ec8bae811617d0e2d80dc43572f16b13ac765d0b08383e299e5ef9c945f56f4f2ac0a0ad6ea9093f5384419078dd88822bd22acbc0b51150141dcfd9d8fc836874f3ad7ea58500535c0dbca6031d610d46e4a7115509491e2d3003e5fc9706bbba795014e3a7ac0278c58fa421c1d1d79223a6fbb4472ebb8d54232255725a9924424449709d525224c449c889c58fd444c123125592711b324b89f4704bc195f39731542ebb4fafcfa39e2da5f272d5121a6b8fe279ff7f2e5256ecc4cae10c544cceb0ae4a461932111a5bf9998604dc6c9b675d435b60f938df274417128c74ff9a848df8674c83a48beb7b4bae74c27e0aab3eec07c565edf3a2bce91116da36a989d9d6151d8d243c148c24b129b4c4b56c4ab1d9026a35a9922f1fc14967a1ad4279d010139c7fa1a26cd28ebeada7187e32698fb708d0638463c29241c3a9d62981303ca4184ccfc1b67b7944d0993d21f35e21c999c9969fa3f0e9cca3af4db49878b64c40ffb65f4a9389d30c6e25a0f0e34309ce709a08ea521e218d9b600d28c7abe7049a828f9f1222a480a893c9fca50db46294e09baaf414a60c7a638c92e6607333660ef3b4fab655fa98eb4b336fae3d64d86a58674c632bf80290ee7f4eea74973c402b336a0edc0712cf96f9c28e05b10af520d97f09aae669b221a9dc0e78f6ffe8cffd80aae88b9298360125bff8ade55bd2089c386259d771ff2242e115f71928c7497d87a986856cc93cfd31d27fea2fa5e8ea365d1a6301f2fe67caf3d3de7ecaf58ad1b0e41e63872193e3cb225215fb724e3add24848bdb6f2d8a18d6bf926c1987ed44ed8940edc716205e9517bc21b16ec4149680097fcd2ca51a60a29f5e17aa7efde53217c740e4ee90b84a3db7519715c068ffd4b2b25be01d00a3bb5019880299c909b83eabd25af244b0495f75b8a85cd12c56170c0c76a2a889431b76822d711b8d96d6091280795a73d286bbbec2611ff2464e5df24cadd16665ee5ad538f704d9e374af54dcb02644712fe5237d0ca3b5ca2900711107c9abfd98af459bb37b9c4a669e3765875cf2a825e0705fd2991fac6ba2bdc8ad25ec5571af221aa665d596f41dd2d0b658d1b9f4506cb1d73e594c8413ff86202d53ea2c512a464a334c0f5c93e61b719bf47ea3da73ffc047eaeacc688c6471eaa4a02eabfc882a36176dcccc27734274ae51353352a0e00096efb1c28302fc36a223ce0f685d4b80e52e506cfc4fc0ba844ee954368a45796747c2fb4cbfdafe7e15e183e98662be7637025cd97d318da26ba4a58bf67cf562916a8c487cbd08a18129a2f3f15e3f15775f1f5786c7e2ffd3efd95e675eaabaed1e344a54dd2848064392174547dbbf1a3cac182cf94220eb0475f02928bd55c3cefdd24ec43af1f702b7c56f53d2ed992fb89ba87434521ad72e4f6f943b9041beb9212757f0021cb1e0f37a077bc40010fbbd4a2ca3b294f97557f8bcac1623c1ed157fa11f843ff7f2df195f43a038fbb5506ec4c86c5b1e44cd6694f092d6c44431f71f2636022a1045969b0e9454f5d1b20292fe909cee37936ca014cad33bd0a3a1188976af42af8a38553db178feaeb848f55cd097eff7f1cc0fd76853c96cb4c865f8d2909eff2aad30b74dadd471975704dbf0147077a6f2ec83b38d89f51326dc0f5fa0d7136cf69172827ba8f7cd73d0254e26ce24deb97f5faa5e7ceac1d31b34fe6865c73f0de8cd493f49c135ab4c391d5a2981000d3bfcb6e619e40f3dec3e28d3857e2d2ddb6235675a10e027b0dcd48e3c00d03980978a8087fa460dbe5f1f80387a443956e14a4eefd860473ce859b57823b37a7aa22819c49e50d99f7485c97d9d94fdfebd8742a3475221434c7043121b1119eb8741308fad93e4cba8899f25842afe6e7ba0afd481d5eaa9b1af5c5b0625e5ed678294e83f4e0200528acd7bf8ad7c1a99944ccee2208de41311f2800598e96c4a5faafa917a9e82bc885c10d15c63871e3ec75755be0750dde560c465fcfaf2ff4e934a514f4464beb5ca62914dad5f6588d90c777149f60a553e664797d29333b6fc86dd088d45cf1b3e7ab3b0fa39c7fdd9547d972716fb08bbfda58d9d5318eeae90a8bb85e572f132ab9e2fd2a560affd02fa15bdd122fbcbf52b791e32d4838f192077c09c9429dc6bb37c1a91af0cdc101d7100437f8c11bc774c03beb3f912f5040a19407588c394deffcf8f5708e5b8fe1da8efae6d8be0f57768f5ca636b58b6f94b6f28ac09006256e829bf5fa5d0d520435b722b23ea3ea6ff0bb1fc0e1e092788e4bbe8892130f0e2ed5fee63733f54227ce791e26ebf59540241735a357238d996fa9badbe00ad7228c552fc429357f85339f19adb3ba0fca015a625cd982451313c3226184b3806dd30d5f2b464cb1bf40cf81ca027a5a582c0ba14f06ea897ba3ae4d660417dcea1e2e1122c6b4b55f6f8b29fd75b02c611cb7dceb62b466c493baf855b611d7c16b3bc32967f8e4e257302400bf5fea2ab1752eedadf3c2bd44c6cbf34ea7fcb136f8bc1e7e127a11da826a6a65db9e82275e7d4f486fd486ed54cd1b0e35a2913784f1b2e5f18e0bc6c412e329511930dbd48bd52fe3b9690da4f90ae380d861c261a1da6f30e6ab96c9937e49c643d80dd82b184079669f96653068610ace123f26350f428e574c0f790fb1dccf22125299ce2c8f5333d7cb4ef0c4e3308cc4d2f0f620828223e59ca4ffefd8eec6c530c1b86eb6e2008e709b935577354d372503dee5930dc29c23be1ca0542ce0ab2f11d94e0d32e1f3538ddf2ac05baf7f5657aa17de28f15500ebaa99d5166b28428ec944505c5e3200285057fbe970aa423b9df0093c34934d4c9b78da803c484a25e256a6b40aae1da42c88a60ccd0558e1d423969a9f680437261f4e72d4214e52654d3c58ba62228220a59f7a7e7c5047a14e6a66920697a8e7c1c5c8cd021cbbf2a5ec46b171781a2e70bcfde810ed214e66f3c6764f5fef5e0421145985963a9ff0e9288b56886fcc6fbc442219a57c07e25489d76ff8263b4943209c95a97b0d2104b57df47c0717df218622ad83a3cfaacc2b4ea2cb4f34b3e69955fcfa19ae5b38aa35ef501ee9e7fd48cfb31c358129f1db3019aa83009ca5cb3c5e9de1c2127db8ac6b74b7a7f38e80fbab3f52b339d24dacd7fbd076c1c6b1e1d4d47e29fbe0db6fbf5535c106b1be1a7efde28cffba02eca546b7686a0eff136687e5eed9f6cc2a9aedf2a6b4d0ec15dff80b61232e6fa178aeaea818e95fb6a9b58adc36645ea6052afe7f8d1fc755008402151b9e828ebb11d80729f003d06c2179912679915513ce846fdd881b25481ebbe054239672be2a9acc15e29c72a7285272ed08845b8de54e6317c3f94ec4548d0bf77e57b77ee3ce6fdcf87da20f701b84c0922d3d4b9a1c120d7e0e1e8986be801d5691adee19e9b774f384791e38502816ec32a0d2274604c4f13fd5129d2fc0715c8817c1627439ba5479154fe0e3931f31bb3a195d05ee8c49d384d9b40938f30062c40b1fa1a0f545d9d02a9e1c1c9c585767672c938c54d119df0a76e2de891730f7b0d2969c2666f6d883137a57e583d7c425817bca979724d750ba126cefe8292f38f8bdab9f1809cd4a59f785402f131222e6dc5fb6f9c6c39cd932b505f08e0052fc1be88a0c0776c247232457ddc829eb1c5f66e935eb22ca12a64899c0f20b3fc2454b258add15b97726331c03f5e088cdd4d58ab9a7faf95057546731f0eb459af0650871fc96e44242506da9ae199ff5f179342d4bd00c2976a6e57b65c0f6e7fdba53175db476efa39c482aa3733ef05141074bc4b2aa0bb7a4c66cabc58594ea95b018b0d98eaf66a6a93a043f8078193044df907cdc042a0517cc8bddeceb6c7a89a77ccc3de30a0acea1e57e7efeaa843705671aebe01a04359f9eb8c5152288f8aff9a74debec98f1b4265b03695dc7aa70b990352bdd96cc2ea3272517d7f64ffbb4686c94696c24ccb494b382df0f849e1781d9c6ae016d03d8653b5ea3723324735be9a9cac6e1a65397b75784328def7c7c3d37dc317f3e4bc2058182664dcd5519244deffc8a7940a4342dc5b334a44444bdcd484282e8385a687c590ac90b013d425d6af8e48d3ef970196fd205ea3dab8b18787682ca9506b8173c31da1e8df7010e9754ec53c5f243af6cc1f3c8b521d18250c82978397535c05afd9a7a8ac84ca3d94c4ef5ca6e26300763c2ce0d3089c4d24b4db937a5df0ba555c4f052895cc83981b6937e5ca8e2b77546d0568296f15cccb9b3f35dca25a9f5fa0ca765cfcaeef056ded4743ba77501ac74b327e979a2c4771c53325cce0dbd649120707035616966b55e471a2e334fc94bfd617e9c19fe24afe96f9641769e3393a271a4ae6474846b577a50fcb20dde928fee62bedaacb4e722be99327970c9580ad4a32435a58c28b1aeb4f4b60d4bb0865de127da1a9003dd39616e04556f981d2add593b0ff9a4dfef1f621e74c84dcf6f2fad03718a3941fec1c17d94fb7842780e0530b8596fb3d5df19c245c3cc81365ea89e5df512cceb5486661dbaf4e05725782828d0ea6f8c0d9476022e45afe57afdceebede0dbc53498effcb61f7b569bbcd233581d2e68b917c4928686f72d4f4916b4f2b373fbcbf9e2a28703489c5ed45399b71abad3f57ac217d71c1cd0de9802e08b6b9a97897e6281aa32629d5679d66b88141bb72d1a09cbb3d50151e2390635018a332aaa0e8cf0a88e90d66b08c49956eb9d67172492da274c51ab7207474a54d9eeca1d1ea67999cce5e4ab9cea57b4c2a228ea50ade3d9b4bef128690e3770184c4d47855697faac27ae23dc16749b7ba3dca71da32705c2a28cae92f797bfc98912288d44c0b8e01d35e2094cb7ee32cec52d870e6a74aed68c562be798fefc7670809eeb3202048d1ff99e9f72bc6d75e96f656dfa5dd7674a728dfa05759628d642af2f8cdc04d4d926a88cb4bca68f9182621b5d26f6a9c4a7ba20a61523cf7bcbe45a3185e52342e5baf03e560a4f37356d4035ae1f7974ac1a7ee1a60abe10e91536c7bb842a84e1d34497363a9766d36f73be6214621a0b730f208bf07e4d1b5c92eaa987c969ffe4b6910f6f932aaa9c32f3c26a5f54aec44e460621e181b0d28785b4f9d3f3905595f8e5291c96182b72338b14bd36536c28a63e9633228f5a9232fb49f338381ca2a78a982a4bda08d300afe78f4acde02770d3e0e309de39420644a9d4fc7300c01084f58e08b19b8e0a9880171eef3012a3a1d47e272c4174eb708598eb74749e5cf8916aacb0f0af9e90510d41db1994c281365d8286521cca84c2d6049c2986418dfaa3f5e1ab3d0f7c282e72eb587ca9e42da9b083c25f71ed843ce613f1b83c218f47997a5fd21f7efad313f238381ac6db27e47175429e53359e7dfcab4ec997b553f2d055b04ecc4704f5180e46323c9803016bd86f2d43f4e01e47cf880b319e9c3a9d0f9d0e4eaba520c637a71e65fe8e933bd336182641b2d44d34ad674e103dc106c7064bcd9b12a77229246b28cc904bb4656db1ac4b372a67ab305673f8ae8eab152ab5716da2f8c085676a5b5e06f5989e5af15e7a87b76df8617bb2ddeba40c5b6e9d750bc2e96cb5a1580e7097c23a77af4efc7b667e9953ef99a5b1952e41b2497a7cd15ad29be2ee512518352c8e557d271ca34d659bafa6d9965d91ce7766e5abb164adb933bbd63fcdf1320baf7656956f35fc9176575bced8ca19e53fe156d387faf88795bd8499d69c4bbb0b31e57ba8daaf5bfdd580db9afbad95bb4819ce99c568cd3cb7328fc7cbd63c9fac3ceab42e6fcdf7dcd8883d177f88f7e2a578233e8a0fe21d98d05fb1157f0e0ee169b89677eec8cfd757eb64bd583ff2d6d962bebecaa2e93abdcdd7d7c9649d14b7eb2891e793f9c5fa8f345dff27cda6eba8905978180d9f03a5349d5e4a730e910e0b16c786106a0805b0b60e6609a02e4c56f924d0225e955d8495d8a619328e956c470f0bbd42d9f2fdb582385f333a8fa4a6c6380539afeaddfac42773a515fd82e5df178494351f5040de2a36c53c4b6f89d1da883fda62206a8312c3d7b1f1bbd5ed8d585ada4f33b4b6989f43c3eb304a6305a47902f1653c87b22393ea9b0745eeaa1cbb6caf6005a37425507497f8153c15316a6e224a1da72cdb8682ab28dfb4e60857e3ba4882678c3d3ef65cde85cc7565ea91c362ca66c57257c55aed9b2b23939741cb0009d95e47b91ce95fab70319789b27534df3299a7f10d9f9b5466844299c1f4c5c7e09df810203ccb74f5d3c306810a799b7816242fa7f91b4499237e714e926eaa3b6ebbd558c65a2ce82a63b651f9e44b8e26d732c2d0a5167e9cfd1c21fe9c3a36ae3c06697b685cf7ddeaf594ef630c27c5384bd3c2f1d6eb32df653a5df5d8345567674c36ec8b2d1375bb39a12eeaac9afb7d2933929363c96ceadfce592146a8eec3c71727a79fc6a76f5e8f9f9f7c3a198f9d8bbf79430e4313fcebecfdbb1e5bb1bb53392116f0f3c7d76e5a89b59e07ef0665b5041772f0a2ea12d461d15b66ecf71df093378c898d8d6849ee4d4bfe7dadac5f802cd7464da2ddc685156bf1b47fdbf00e3466a0bb6e23d4864b5011e1a853dd08bc5ef7c5549dcf284776be6f98fdd5a79e421b38cb5058ca79ad6eefde2b73efb1dbbea386b8e07ae2e575720a2664bd3e32316f7560a669d75d7407dee38977b8f0c435b601746243c591f687c9f1629810f3a97752df335f70d5a0e14e93e39433e9f3fd19b1ca561bf9a10b0b56154ee0901f5588138e76814bde3918c662981d476c0aeab26a9da4e539c9eac77c35621ce099e03d53e31ad33b09413701df1f3e9af8fde1b813dc08a4768265e7465c6b17e23b3f7c3ceb848f2762e58f05f7c1cf85ea804f745d8bbcf7eacbd47c31617861cd10af5cbc8a39a1111d4129f7af71ffceedc9cbc19f2f79cdd0562f38267ac221dfad354f1a6b2e1670b29fca30d69b4add2d4ddbeef0e87f838799b208d30b0c27abac5ac6bbe36c78072593163402797e572ddb5db96c666ead351ef4fb8f73cd4fc022aaaa7dd6ac7dde096654d9e3855e48ab96f9e1948d10c6c7fad2b81bb1a2cae2aa8b48bc0ad871cc51b1ab1cd644d390ff5f61d7d2e3280c837fcbde9a2668608f40dac3a887de47da034255a6850a899768fa9286ffbe7e040aedceeca90a753e3b5f9dc425601feb475c71d58b92bc5a8111a0b924cd573955d62ed10ce1ed9724fb564647fe3a2f1b80819971c57c65bd1bca8872f91104b717f2bb7be8ab5b789477e77397c1b35a5840eee8ab7864d551252368ee45b4d16d3f9671f9d49806708775d87db59931faf9cc2875f8d0ef6aebe41a9a509ff8930da7da30b5f0647b8be47fc82655ef52922c262a37b3ccee6f5b9c9793de8a8fc94b42b83c2f1fa55b3eb64b4616130a3640c10b016620005fa529a704486d26ac6cf062ffcdfcdc4ca6e2b9ef876a75ff5f67f956bcaa14ada866742d6563edbba02d49b976a1793ca65c99767e40e024b3b477e9794eaf45666ab758e2190350e8c7daacb3d884d9ca7043ca2cf4bc6cf86bff13c0593b08d8d363d8cd57363a6b6a3e81f88f9b03587572da98bfdeea544008ce3ec35662ce222f40453528aa4151cd8d414df39dad5320b216a0ec3314d9fc02f6b0b999da8c0dac283b70b707eef6c05da31d7b7b00d98b53d2a454cb183e317329b4bd00af846ebb2af461e0ee00dc1d80bb62e0ee00100781b5a9920221fcd48da2d54114a09a16b4b6a43560ad2d74690503e72c65d73900e7041c30700e52392f491d5352b111cdba8a9bb05a354c4603b215c856a26332923105fc293983553016e586e8d1152cf8754edd46087be0afc03dd8e8c3de3d1e1455313fb14885b3aaafaf2ea9b06b8c8fab2f76da35a17b25228287e183a55d7249a9945ac92ca27ab818a4b4101a4f07ca7afab742a78c2ce52542077d72073eeb3bb98901a3c7bb87852e1383983695b8eb1b194445acbb751d7761bdea224c9d094d296be0a3162717bde36338d17833602152dc26e1cf14a69a310f4cb42ba04a684644438767c3e818d239059965d9e5a5f3530b7e6a5735bb18faa90553ac28fe614a313705c281fb9fdbf125b01f4302088f6fd9610791aae52c8c1007630041e9f2e6321cb29d5002a34a7c3355447f01f0a7ee4bf70b0100",
 	})
 	if err != nil {
 		panic(err)